@@ -0,0 +1,292 @@
+package gogohbase
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// 选择地址的策略
+const (
+	BalanceRoundRobin = iota //轮询
+	BalanceLeastConn         //链接数最少
+)
+
+const (
+	defaultMaxFails   = 3                //连续失败多少次后熔断
+	defaultMinBackoff = time.Second      //第一次熔断的时长
+	defaultMaxBackoff = 60 * time.Second //熔断的最大时长
+)
+
+var ErrNoAddr = errors.New("No address to dial")
+
+// 返回当前可用的thrift网关地址
+type Resolver func() ([]string, error)
+
+/*
+AddrStats is the state of one thrift gateway of a ThriftPool
+*/
+type AddrStats struct {
+	Addr     string
+	Conns    int          //打开的链接数
+	Healthy  bool         //熔断器是否关闭
+	State    BreakerState //熔断器的状态,open时在RetryAt之前不会Dial
+	Failures int          //连续的Dial/健康检查/传输层失败次数
+	RetryAt  time.Time    //open时进入half-open的时间
+}
+
+type addrState struct {
+	addr     string
+	conns    int
+	state    BreakerState
+	failures int
+	backoff  time.Duration //熔断的时长,half-open探测失败时翻倍
+	retryAt  time.Time     //open结束的时间
+	probes   int           //half-open时正在进行的探测
+	removed  bool          //resolver不再返回,链接全部关闭后删除
+}
+
+// 在多个地址之间分配Dial,并且每个地址有一个熔断器
+type balancer struct {
+	lock           sync.Mutex
+	policy         int
+	addrs          []*addrState
+	next           int
+	maxFails       int
+	minBackoff     time.Duration
+	maxBackoff     time.Duration
+	halfOpenProbes int
+	onChange       func(addr string, from, to BreakerState)
+}
+
+func newBalancer(addrs []string) *balancer {
+	b := &balancer{
+		policy:         BalanceRoundRobin,
+		maxFails:       defaultMaxFails,
+		minBackoff:     defaultMinBackoff,
+		maxBackoff:     defaultMaxBackoff,
+		halfOpenProbes: defaultHalfOpenProbes,
+	}
+	b.update(addrs)
+	return b
+}
+
+// 替换地址列表,已经移除的地址保留到链接全部关闭
+func (b *balancer) update(addrs []string) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	keep := make(map[string]bool, len(addrs))
+	for _, addr := range addrs {
+		keep[addr] = true
+	}
+
+	states := make([]*addrState, 0, len(addrs))
+	for _, a := range b.addrs {
+		a.removed = !keep[a.addr]
+		if !a.removed || a.conns > 0 {
+			states = append(states, a)
+		}
+		delete(keep, a.addr)
+	}
+
+	for _, addr := range addrs {
+		if keep[addr] {
+			states = append(states, &addrState{addr: addr})
+			delete(keep, addr)
+		}
+	}
+	b.addrs = states
+}
+
+// 选出下一个Dial的地址:跳过熔断的地址,open超时的进入half-open并允许有限的探测,
+// 全部熔断时快速失败
+func (b *balancer) pick() (string, error) {
+	b.lock.Lock()
+
+	now := nowFunc()
+	var changes []stateChange
+	var picked, open *addrState
+	for i := range b.addrs {
+		a := b.addrs[(b.next+i)%len(b.addrs)]
+		if a.removed {
+			continue
+		}
+
+		if a.state == BreakerOpen && !now.Before(a.retryAt) {
+			changes = append(changes, b.setState(a, BreakerHalfOpen))
+		}
+		if a.state == BreakerOpen || (a.state == BreakerHalfOpen && a.probes >= b.halfOpenProbes) {
+			if open == nil || a.retryAt.Before(open.retryAt) {
+				open = a
+			}
+			continue
+		}
+
+		if picked == nil || (b.policy == BalanceLeastConn && a.conns < picked.conns) {
+			picked = a
+		}
+		if b.policy == BalanceRoundRobin {
+			break
+		}
+	}
+
+	if picked == nil {
+		b.lock.Unlock()
+		b.notify(changes...)
+		if open != nil {
+			return "", &BreakerOpenError{Addr: open.addr, RetryAt: open.retryAt}
+		}
+		return "", ErrNoAddr
+	}
+
+	for i, a := range b.addrs {
+		if a == picked {
+			b.next = i + 1
+			break
+		}
+	}
+
+	if picked.state == BreakerHalfOpen {
+		picked.probes += 1
+	}
+	//正在Dial的链接也计入,避免并发Dial都落到同一个地址
+	picked.conns += 1
+	b.lock.Unlock()
+
+	b.notify(changes...)
+	return picked.addr, nil
+}
+
+func (b *balancer) find(addr string) *addrState {
+	for _, a := range b.addrs {
+		if a.addr == addr {
+			return a
+		}
+	}
+	return nil
+}
+
+// Dial成功或者健康检查成功,关闭熔断器
+func (b *balancer) succeed(addr string) {
+	b.lock.Lock()
+	a := b.find(addr)
+	if a == nil {
+		b.lock.Unlock()
+		return
+	}
+
+	a.failures = 0
+	a.backoff = 0
+	change := b.setState(a, BreakerClosed)
+	b.lock.Unlock()
+
+	b.notify(change)
+}
+
+// rpc没有出现传输层错误,只清零连续失败次数,熔断器由探测关闭
+func (b *balancer) rpcSucceeded(addr string) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if a := b.find(addr); a != nil && a.state == BreakerClosed {
+		a.failures = 0
+	}
+}
+
+// Dial失败,归还pick时占用的链接数
+func (b *balancer) dialFailed(addr string) {
+	b.closed(addr)
+	b.fail(addr)
+}
+
+// Dial、健康检查或者rpc失败:连续失败maxFails次后熔断,half-open的探测失败时熔断时长翻倍
+func (b *balancer) fail(addr string) {
+	b.lock.Lock()
+	a := b.find(addr)
+	if a == nil {
+		b.lock.Unlock()
+		return
+	}
+
+	var change stateChange
+	a.failures += 1
+	switch a.state {
+	case BreakerClosed:
+		if a.failures < b.maxFails {
+			b.lock.Unlock()
+			return
+		}
+		a.backoff = b.minBackoff
+		change = b.setState(a, BreakerOpen)
+	case BreakerHalfOpen:
+		if a.backoff *= 2; a.backoff > b.maxBackoff {
+			a.backoff = b.maxBackoff
+		}
+		change = b.setState(a, BreakerOpen)
+	}
+	b.lock.Unlock()
+
+	b.notify(change)
+}
+
+// 当前使用中的地址
+func (b *balancer) addrList() []string {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	addrs := make([]string, 0, len(b.addrs))
+	for _, a := range b.addrs {
+		if !a.removed {
+			addrs = append(addrs, a.addr)
+		}
+	}
+	return addrs
+}
+
+// 链接关闭
+func (b *balancer) closed(addr string) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	for i, a := range b.addrs {
+		if a.addr != addr {
+			continue
+		}
+
+		if a.conns > 0 {
+			a.conns -= 1
+		}
+		if a.removed && a.conns == 0 {
+			b.addrs = append(b.addrs[:i], b.addrs[i+1:]...)
+		}
+		return
+	}
+}
+
+// 地址已经被resolver移除
+func (b *balancer) removed(addr string) bool {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	a := b.find(addr)
+	return a != nil && a.removed
+}
+
+func (b *balancer) stats() []AddrStats {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	stats := make([]AddrStats, 0, len(b.addrs))
+	for _, a := range b.addrs {
+		stats = append(stats, AddrStats{
+			Addr:     a.addr,
+			Conns:    a.conns,
+			Healthy:  a.state == BreakerClosed,
+			State:    a.state,
+			Failures: a.failures,
+			RetryAt:  a.retryAt,
+		})
+	}
+	return stats
+}
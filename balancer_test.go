@@ -0,0 +1,213 @@
+package gogohbase_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	goh "github.com/blackbeans/gogobase"
+	"github.com/blackbeans/gogobase/hbasetest"
+)
+
+// addrStats returns the stats of addr, the zero value if the pool does not know it
+func addrStats(pool *goh.ThriftPool, addr string) goh.AddrStats {
+	for _, stats := range pool.GetAddrStats() {
+		if stats.Addr == addr {
+			return stats
+		}
+	}
+	return goh.AddrStats{}
+}
+
+// getN borrows n clients and fails the test on error
+func getN(t *testing.T, pool *goh.ThriftPool, n int) []*goh.IdleClient {
+	clients := make([]*goh.IdleClient, 0, n)
+	for i := 0; i < n; i++ {
+		c, err := pool.Get()
+		if err != nil {
+			t.Fatalf("Get %d: %v", i, err)
+		}
+		clients = append(clients, c)
+	}
+	return clients
+}
+
+func getTableNames(cli *goh.HClient) error {
+	_, err := cli.GetTableNames()
+	return err
+}
+
+func TestBalanceRoundRobin(t *testing.T) {
+	a, b := hbasetest.NewServer(), hbasetest.NewServer()
+	defer a.Close()
+	defer b.Close()
+
+	pool := goh.NewPool(a.Addr, goh.WithAddrs(b.Addr))
+	defer pool.Destroy()
+
+	clients := getN(t, pool, 4)
+	if sa, sb := addrStats(pool, a.Addr), addrStats(pool, b.Addr); sa.Conns != 2 || sb.Conns != 2 {
+		t.Fatalf("got %+v and %+v, want 2 connections each", sa, sb)
+	}
+	for _, c := range clients {
+		pool.Put(c)
+	}
+}
+
+func TestBalanceLeastConn(t *testing.T) {
+	a, b := hbasetest.NewServer(), hbasetest.NewServer()
+	defer a.Close()
+	defer b.Close()
+
+	pool := goh.NewPool(a.Addr, goh.WithAddrs(b.Addr), goh.WithBalance(goh.BalanceLeastConn))
+	defer pool.Destroy()
+
+	//a, b, a, b: the ties go to the next gateway in turn
+	clients := getN(t, pool, 4)
+	pool.CloseErrConn(clients[0])
+	pool.CloseErrConn(clients[2])
+	if sa, sb := addrStats(pool, a.Addr), addrStats(pool, b.Addr); sa.Conns != 0 || sb.Conns != 2 {
+		t.Fatalf("got %+v and %+v, want the connections of a closed", sa, sb)
+	}
+
+	//both new connections go to a, round-robin would split them
+	clients = append(clients, getN(t, pool, 2)...)
+	if sa, sb := addrStats(pool, a.Addr), addrStats(pool, b.Addr); sa.Conns != 2 || sb.Conns != 2 {
+		t.Fatalf("got %+v and %+v, want 2 connections each", sa, sb)
+	}
+	for _, c := range clients[4:] {
+		pool.Put(c)
+	}
+	pool.Put(clients[1])
+	pool.Put(clients[3])
+}
+
+func TestBalanceFailover(t *testing.T) {
+	a, b := hbasetest.NewServer(), hbasetest.NewServer()
+	defer a.Close()
+
+	//b is dialed at the address of its current server, which is restarted below
+	var (
+		lock    sync.Mutex
+		backend = b.Addr
+	)
+	pool := goh.NewPool(a.Addr, goh.WithAddrs(b.Addr),
+		goh.WithBreaker(goh.BreakerOpts{MaxFailures: 1, OpenTimeout: 100 * time.Millisecond}))
+	defer pool.Destroy()
+	dial := pool.Dial
+	pool.Dial = func(addr string) (*goh.IdleClient, error) {
+		if addr == b.Addr {
+			lock.Lock()
+			addr = backend
+			lock.Unlock()
+		}
+		return dial(addr)
+	}
+
+	for _, c := range getN(t, pool, 4) {
+		pool.Put(c)
+	}
+
+	//the idle connections to b and the dials of b fail until its breaker opens
+	b.Close()
+	failures := 0
+	for i := 0; i < 20; i++ {
+		if err := pool.Do(getTableNames); err != nil {
+			if failures++; failures > 3 {
+				t.Fatalf("call %d: %v, want the calls moved to a", i, err)
+			}
+		}
+	}
+	if failures == 0 {
+		t.Fatal("no call failed on the stopped gateway")
+	}
+	if sb := addrStats(pool, b.Addr); sb.State != goh.BreakerOpen || sb.Healthy || sb.Conns != 0 {
+		t.Fatalf("got %+v, want the breaker of b open", sb)
+	}
+	if sa := addrStats(pool, a.Addr); sa.State != goh.BreakerClosed || sa.Conns == 0 {
+		t.Fatalf("got %+v, want a serving the calls", sa)
+	}
+
+	//b is not dialed before the open timeout, a takes all the new connections
+	restarted := hbasetest.NewServer()
+	defer restarted.Close()
+	lock.Lock()
+	backend = restarted.Addr
+	lock.Unlock()
+	clients := getN(t, pool, 3)
+	if sb := addrStats(pool, b.Addr); sb.Conns != 0 {
+		t.Fatalf("got %+v, want b skipped while open", sb)
+	}
+	for _, c := range clients {
+		pool.Put(c)
+	}
+
+	//then a dial probes it and closes the breaker
+	time.Sleep(150 * time.Millisecond)
+	clients = getN(t, pool, 4)
+	if sb := addrStats(pool, b.Addr); sb.State != goh.BreakerClosed || sb.Conns == 0 {
+		t.Fatalf("got %+v, want b probed and closed", sb)
+	}
+	for _, c := range clients {
+		if err := getTableNames(c.Client); err != nil {
+			t.Fatal(err)
+		}
+		pool.Put(c)
+	}
+}
+
+func TestResolverRefresh(t *testing.T) {
+	a, b := hbasetest.NewServer(), hbasetest.NewServer()
+	defer a.Close()
+	defer b.Close()
+
+	var (
+		lock  sync.Mutex
+		addrs = []string{a.Addr}
+		fail  error
+	)
+	resolver := func() ([]string, error) {
+		lock.Lock()
+		defer lock.Unlock()
+		return addrs, fail
+	}
+	pool := goh.NewPool("unused:9090", goh.WithResolver(resolver), goh.WithHealthCheckInterval(10*time.Millisecond))
+	defer pool.Destroy()
+
+	if err := pool.Do(getTableNames); err != nil {
+		t.Fatal(err)
+	}
+	if stats := pool.GetAddrStats(); len(stats) != 1 || stats[0].Addr != a.Addr || stats[0].Conns != 1 {
+		t.Fatalf("got %+v, want the address of the resolver", stats)
+	}
+
+	//a failed resolve keeps the addresses
+	lock.Lock()
+	fail = errors.New("resolver down")
+	lock.Unlock()
+	time.Sleep(30 * time.Millisecond)
+	if stats := pool.GetAddrStats(); len(stats) != 1 || stats[0].Addr != a.Addr {
+		t.Fatalf("got %+v, want a kept", stats)
+	}
+
+	//a is dropped with its idle connection once b replaces it
+	lock.Lock()
+	addrs, fail = []string{b.Addr}, nil
+	lock.Unlock()
+	for deadline := time.Now().Add(time.Second); ; time.Sleep(5 * time.Millisecond) {
+		stats := pool.GetAddrStats()
+		if len(stats) == 1 && stats[0].Addr == b.Addr {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("got %+v, want only b", stats)
+		}
+	}
+	if err := pool.Do(getTableNames); err != nil {
+		t.Fatal(err)
+	}
+	if sb := addrStats(pool, b.Addr); sb.Conns != 1 {
+		t.Fatalf("got %+v, want the new connection on b", sb)
+	}
+}
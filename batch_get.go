@@ -0,0 +1,196 @@
+package gogohbase
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/blackbeans/gogobase/proto"
+)
+
+// defaults of BatchGetOpts
+const (
+	defaultBatchChunkSize   = 1000
+	defaultBatchConcurrency = 4
+)
+
+/*
+BatchGetOpts configures a BatchGet, the zero values take the defaults
+*/
+type BatchGetOpts struct {
+	ChunkSize   int               //keys of each GetRows, 1000 by default
+	Concurrency int               //chunks read at once, 4 by default
+	Columns     []string          //columns to read, all by default
+	Timestamp   int64             //read the versions at this timestamp, 0 means the latest
+	Attributes  map[string]string //attributes of every GetRows
+	Retry       *RetryPolicy      //retries the failed chunks, nil means no retry
+}
+
+/*
+BatchGetRow is the result of one key of a BatchGet
+*/
+type BatchGetRow struct {
+	Key   []byte
+	Row   *proto.TRowResult_ //nil when the row is missing or its chunk failed
+	Found bool               //false when the row does not exist or its chunk failed
+	Err   error              //error of the chunk of the key after the retries
+}
+
+/*
+ChunkError is the error of one chunk of keys of a BatchGet after its retries
+*/
+type ChunkError struct {
+	Keys     [][]byte
+	Attempts int //GetRows sent
+	Err      error
+}
+
+func (e *ChunkError) Error() string {
+	return fmt.Sprintf("chunk of %d keys failed after %d attempts: %v", len(e.Keys), e.Attempts, e.Err)
+}
+
+func (e *ChunkError) Unwrap() error {
+	return e.Err
+}
+
+/*
+BatchGetError aggregates the failed chunks of a BatchGet
+*/
+type BatchGetError []*ChunkError
+
+func (e BatchGetError) Error() string {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "%d chunks failed:", len(e))
+	for _, ce := range e {
+		b.WriteString(" ")
+		b.WriteString(ce.Error())
+		b.WriteString(";")
+	}
+	return b.String()
+}
+
+/*
+BatchGet reads the rows of keys with GetRows (or GetRowsWithColumns, GetRowsTs...)
+of ChunkSize keys, running at most Concurrency chunks at once on separate pooled
+clients, so a large key set is spread over the gateways of the pool.
+
+The results are in the order of keys, one per key, the missing rows have Found false.
+A failed chunk is retried as told by opts.Retry, then its keys carry the error and BatchGet returns a
+BatchGetError along with the rows of the other chunks.
+*/
+func (p *ThriftPool) BatchGet(ctx context.Context, tableName string, keys [][]byte, opts BatchGetOpts) ([]BatchGetRow, error) {
+	if opts.ChunkSize <= 0 {
+		opts.ChunkSize = defaultBatchChunkSize
+	}
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = defaultBatchConcurrency
+	}
+
+	results := make([]BatchGetRow, len(keys))
+	for i, key := range keys {
+		results[i].Key = key
+	}
+
+	var (
+		wg     sync.WaitGroup
+		lock   sync.Mutex
+		errs   BatchGetError
+		tokens = make(chan struct{}, opts.Concurrency)
+	)
+
+	for start := 0; start < len(keys); start += opts.ChunkSize {
+		end := start + opts.ChunkSize
+		if end > len(keys) {
+			end = len(keys)
+		}
+
+		select {
+		case tokens <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		go func(chunk []BatchGetRow) {
+			defer func() {
+				<-tokens
+				wg.Done()
+			}()
+
+			if err := p.batchGetChunk(ctx, tableName, chunk, opts); err != nil {
+				lock.Lock()
+				errs = append(errs, err)
+				lock.Unlock()
+			}
+		}(results[start:end])
+	}
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return results, err
+	}
+	if len(errs) > 0 {
+		return results, errs
+	}
+	return results, nil
+}
+
+// batchGetChunk reads the rows of chunk in place, retrying the failed GetRows as told by opts.Retry
+func (p *ThriftPool) batchGetChunk(ctx context.Context, tableName string, chunk []BatchGetRow, opts BatchGetOpts) *ChunkError {
+	keys := make([][]byte, len(chunk))
+	for i := range chunk {
+		keys[i] = chunk[i].Key
+	}
+
+	var (
+		rows     []*proto.TRowResult_
+		err      error
+		attempts int
+	)
+	getChunk := func(ctx context.Context, cli *HClient) (e error) {
+		attempts++
+		rows, e = getRows(ctx, cli, tableName, keys, opts)
+		return
+	}
+	if opts.Retry != nil {
+		err = p.DoRetry(ctx, opts.Retry, getChunk)
+	} else {
+		err = p.DoContext(ctx, func(cli *HClient) error { return getChunk(ctx, cli) })
+	}
+
+	if err != nil {
+		for i := range chunk {
+			chunk[i].Err = err
+		}
+		return &ChunkError{Keys: keys, Attempts: attempts, Err: err}
+	}
+
+	//GetRows不返回不存在的行,按rowkey对应回去
+	found := make(map[string]*proto.TRowResult_, len(rows))
+	for _, row := range rows {
+		found[string(row.Row)] = row
+	}
+	for i := range chunk {
+		if row, ok := found[string(chunk[i].Key)]; ok {
+			chunk[i].Row = row
+			chunk[i].Found = true
+		}
+	}
+	return nil
+}
+
+// getRows calls the GetRows variant matching the columns and the timestamp of opts
+func getRows(ctx context.Context, cli *HClient, tableName string, keys [][]byte, opts BatchGetOpts) ([]*proto.TRowResult_, error) {
+	switch {
+	case len(opts.Columns) > 0 && opts.Timestamp > 0:
+		return cli.GetRowsWithColumnsTsCtx(ctx, tableName, keys, opts.Columns, opts.Timestamp, opts.Attributes)
+	case len(opts.Columns) > 0:
+		return cli.GetRowsWithColumnsCtx(ctx, tableName, keys, opts.Columns, opts.Attributes)
+	case opts.Timestamp > 0:
+		return cli.GetRowsTsCtx(ctx, tableName, keys, opts.Timestamp, opts.Attributes)
+	}
+	return cli.GetRowsCtx(ctx, tableName, keys, opts.Attributes)
+}
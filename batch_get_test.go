@@ -0,0 +1,141 @@
+package gogohbase_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"git.apache.org/thrift.git/lib/go/thrift"
+	goh "github.com/blackbeans/gogobase"
+	"github.com/blackbeans/gogobase/hbasetest"
+	"github.com/blackbeans/gogobase/proto"
+)
+
+func TestBatchGetKeyOrder(t *testing.T) {
+	srv, pool := scanFixture(t, 20)
+	defer srv.Close()
+	defer pool.Destroy()
+
+	//unsorted, with missing rows and a duplicate, over chunks of 3 keys
+	var keys [][]byte
+	for _, key := range []string{"row17", "missing1", "row03", "row11", "row03", "row00", "missing2", "row19", "row08", "row12"} {
+		keys = append(keys, []byte(key))
+	}
+	rows, err := pool.BatchGet(context.Background(), "t", keys, goh.BatchGetOpts{ChunkSize: 3, Concurrency: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != len(keys) {
+		t.Fatalf("got %d results, want %d", len(rows), len(keys))
+	}
+
+	for i, row := range rows {
+		if !bytes.Equal(row.Key, keys[i]) {
+			t.Fatalf("result %d: got the key %s, want %s", i, row.Key, keys[i])
+		}
+		if row.Err != nil {
+			t.Fatalf("%s: %v", row.Key, row.Err)
+		}
+
+		var n int
+		if _, err := fmt.Sscanf(string(row.Key), "row%d", &n); err != nil {
+			if row.Found || row.Row != nil {
+				t.Fatalf("%s: got %v, want not found", row.Key, row.Row)
+			}
+			continue
+		}
+		if !row.Found || !bytes.Equal(row.Row.Row, row.Key) || string(row.Row.Columns["cf:a"].Value) != fmt.Sprint(n) {
+			t.Fatalf("%s: got %v, found %v, want cf:a=%d", row.Key, row.Row, row.Found, n)
+		}
+	}
+}
+
+func TestBatchGetMissingTable(t *testing.T) {
+	srv, pool := scanFixture(t, 1)
+	defer srv.Close()
+	defer pool.Destroy()
+
+	keys := [][]byte{[]byte("row00"), []byte("a"), []byte("b"), []byte("c"), []byte("d")}
+	rows, err := pool.BatchGet(context.Background(), "missing", keys, goh.BatchGetOpts{ChunkSize: 2})
+
+	var errs goh.BatchGetError
+	if !errors.As(err, &errs) || len(errs) != 3 {
+		t.Fatalf("got %v, want the 3 chunks failed", err)
+	}
+	for _, ce := range errs {
+		if !errors.Is(ce, goh.ErrTableNotFound) || ce.Attempts != 1 {
+			t.Fatalf("got %v, want a single GetRows failed with ErrTableNotFound", ce)
+		}
+	}
+	for _, row := range rows {
+		if !errors.Is(row.Err, goh.ErrTableNotFound) || row.Found || row.Row != nil {
+			t.Fatalf("%s: got %+v, want the error of its chunk", row.Key, row)
+		}
+	}
+}
+
+// failingGets fails the GetRows of the chunks holding the key bad
+type failingGets struct {
+	*hbasetest.Fake
+	bad string
+}
+
+func (f *failingGets) GetRows(tableName proto.Text, rows [][]byte, attributes map[string]proto.Text) ([]*proto.TRowResult_, error) {
+	for _, row := range rows {
+		if string(row) == f.bad {
+			return nil, &proto.IOError{Message: "region server down"}
+		}
+	}
+	return f.Fake.GetRows(tableName, rows, attributes)
+}
+
+func TestBatchGetFailedChunk(t *testing.T) {
+	fake := hbasetest.NewFake()
+	fake.MustCreateTable("t", "cf")
+	var keys [][]byte
+	for i := 0; i < 9; i++ {
+		key := []byte(fmt.Sprintf("row%d", i))
+		keys = append(keys, key)
+		mutations := []*proto.Mutation{{Column: proto.Text("cf:a"), Value: proto.Text(fmt.Sprint(i))}}
+		if err := fake.MutateRow(proto.Text("t"), key, mutations, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	socket, err := thrift.NewTServerSocket("127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := thrift.NewTSimpleServer4(proto.NewHbaseProcessor(&failingGets{Fake: fake, bad: "row4"}), socket,
+		thrift.NewTTransportFactory(), thrift.NewTBinaryProtocolFactoryDefault())
+	if err := server.Listen(); err != nil {
+		t.Fatal(err)
+	}
+	go server.AcceptLoop()
+	defer server.Stop()
+
+	pool := goh.NewPool(socket.Addr().String())
+	defer pool.Destroy()
+
+	//the chunk row3..row5 fails, the rows of the other chunks are read
+	rows, err := pool.BatchGet(context.Background(), "t", keys, goh.BatchGetOpts{ChunkSize: 3})
+	var errs goh.BatchGetError
+	if !errors.As(err, &errs) || len(errs) != 1 || len(errs[0].Keys) != 3 || string(errs[0].Keys[0]) != "row3" {
+		t.Fatalf("got %v, want the chunk of row4 failed", err)
+	}
+	var herr *goh.HbaseError
+	if !errors.As(errs[0], &herr) || herr.IOErr == nil {
+		t.Fatalf("got %v, want the IOError of the gateway", errs[0].Err)
+	}
+	for i, row := range rows {
+		failed := i >= 3 && i < 6
+		if failed && (row.Err != errs[0].Err || row.Found || row.Row != nil) {
+			t.Fatalf("%s: got %+v, want the error of its chunk", row.Key, row)
+		}
+		if !failed && (row.Err != nil || !row.Found || string(row.Row.Columns["cf:a"].Value) != fmt.Sprint(i)) {
+			t.Fatalf("%s: got %+v, want the row read", row.Key, row)
+		}
+	}
+}
@@ -0,0 +1,138 @@
+package gogohbase
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+const defaultHalfOpenProbes = 1
+
+/*
+BreakerState is the state of the circuit breaker of a thrift gateway
+*/
+type BreakerState int
+
+const (
+	BreakerClosed   BreakerState = iota //the gateway is dialed as usual
+	BreakerOpen                         //the gateway is skipped until its open timeout elapses
+	BreakerHalfOpen                     //a few dials probe whether the gateway is back
+)
+
+func (s BreakerState) String() string {
+	switch s {
+	case BreakerClosed:
+		return "closed"
+	case BreakerOpen:
+		return "open"
+	case BreakerHalfOpen:
+		return "half-open"
+	}
+	return fmt.Sprintf("BreakerState(%d)", int(s))
+}
+
+var ErrBreakerOpen = errors.New("Circuit breaker is open")
+
+/*
+BreakerOpenError is returned instead of dialing when the breakers of all the
+gateways are open, errors.Is(err, ErrBreakerOpen) reports it
+*/
+type BreakerOpenError struct {
+	Addr    string    //the gateway closest to a new probe
+	RetryAt time.Time //when it may be probed again
+}
+
+func (e *BreakerOpenError) Error() string {
+	return fmt.Sprintf("circuit breaker of %s is open until %s", e.Addr, e.RetryAt.Format(time.RFC3339Nano))
+}
+
+func (e *BreakerOpenError) Is(target error) bool {
+	return target == ErrBreakerOpen
+}
+
+/*
+BreakerOpts configures the circuit breaker of each gateway, the zero values take the defaults.
+
+A breaker opens after MaxFailures failed dials, health checks or rpcs with a
+transport error in a row. The gateway is not dialed while it is open, then up to
+HalfOpenProbes dials probe it: the first success closes the breaker, a failure
+opens it again for twice as long, up to MaxOpenTimeout.
+*/
+type BreakerOpts struct {
+	MaxFailures    int           //consecutive failures opening the breaker, 3 by default
+	OpenTimeout    time.Duration //first time open, 1s by default
+	MaxOpenTimeout time.Duration //longest time open, 60s by default
+	HalfOpenProbes int           //dials at once while half-open, 1 by default
+
+	//OnStateChange is called on every change of state, outside of the locks of the pool
+	OnStateChange func(addr string, from, to BreakerState)
+}
+
+/*
+WithBreaker configures the circuit breakers of the gateways
+*/
+func WithBreaker(opts BreakerOpts) Option {
+	return func(p *ThriftPool) {
+		b := p.balancer
+		b.lock.Lock()
+		if opts.MaxFailures > 0 {
+			b.maxFails = opts.MaxFailures
+		}
+		if opts.OpenTimeout > 0 {
+			b.minBackoff = opts.OpenTimeout
+		}
+		if opts.MaxOpenTimeout > 0 {
+			b.maxBackoff = opts.MaxOpenTimeout
+		}
+		if b.maxBackoff < b.minBackoff {
+			b.maxBackoff = b.minBackoff
+		}
+		if opts.HalfOpenProbes > 0 {
+			b.halfOpenProbes = opts.HalfOpenProbes
+		}
+		b.lock.Unlock()
+		p.onBreakerChange = opts.OnStateChange
+	}
+}
+
+// a change of state of the breaker of addr
+type stateChange struct {
+	addr     string
+	from, to BreakerState
+}
+
+// setState moves the breaker of a to state, the lock of the balancer must be held
+func (b *balancer) setState(a *addrState, to BreakerState) stateChange {
+	change := stateChange{addr: a.addr, from: a.state, to: to}
+	a.state = to
+	a.probes = 0
+	if to == BreakerOpen {
+		a.retryAt = nowFunc().Add(a.backoff)
+	}
+	return change
+}
+
+// notify passes the changes to onChange, without the lock of the balancer
+func (b *balancer) notify(changes ...stateChange) {
+	if b.onChange == nil {
+		return
+	}
+	for _, c := range changes {
+		if c.from != c.to {
+			b.onChange(c.addr, c.from, c.to)
+		}
+	}
+}
+
+// breakerChanged logs the changes of state of the breakers and calls the hook of WithBreaker
+func (p *ThriftPool) breakerChanged(addr string, from, to BreakerState) {
+	if to == BreakerOpen {
+		p.logger.Warn("thrift pool circuit breaker opened", "addr", addr, "from", from.String())
+	} else {
+		p.logger.Info("thrift pool circuit breaker changed", "addr", addr, "from", from.String(), "to", to.String())
+	}
+
+	if p.onBreakerChange != nil {
+		p.onBreakerChange(addr, from, to)
+	}
+}
@@ -0,0 +1,131 @@
+package gogohbase_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	goh "github.com/blackbeans/gogobase"
+	"github.com/blackbeans/gogobase/hbasetest"
+)
+
+func TestBreakerIgnoresCallerDeadline(t *testing.T) {
+	srv := hbasetest.NewServer()
+	defer srv.Close()
+
+	pool := goh.NewPool(srv.Addr, goh.WithBreaker(goh.BreakerOpts{MaxFailures: 3}))
+	defer pool.Destroy()
+	if err := pool.Warmup(context.Background(), 3); err != nil {
+		t.Fatal(err)
+	}
+
+	expired, cancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancel()
+	for i := 0; i < 3; i++ {
+		err := pool.Do(func(cli *goh.HClient) error {
+			_, err := cli.GetTableNamesCtx(expired)
+			return err
+		})
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("call %d: got %v, want context.DeadlineExceeded", i, err)
+		}
+		if errors.Is(err, goh.ErrTransport) {
+			t.Fatalf("call %d: deadline classified as a transport error", i)
+		}
+	}
+
+	if state := pool.GetAddrStats()[0].State; state != goh.BreakerClosed {
+		t.Fatalf("breaker is %v after caller deadlines, want closed", state)
+	}
+	if stats := pool.Stats(); stats.Idle != 3 || stats.Dials != 3 {
+		t.Fatalf("idle connections were closed: %+v", stats)
+	}
+	if err := pool.Do(func(cli *goh.HClient) error {
+		_, err := cli.GetTableNames()
+		return err
+	}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestBreakerTransitions(t *testing.T) {
+	srv := hbasetest.NewServer()
+	defer srv.Close()
+
+	var (
+		lock    sync.Mutex
+		changes []string
+		failing int32 = 1
+	)
+	pool := goh.NewPool(srv.Addr, goh.WithBreaker(goh.BreakerOpts{
+		MaxFailures: 2,
+		OpenTimeout: 50 * time.Millisecond,
+		OnStateChange: func(addr string, from, to goh.BreakerState) {
+			lock.Lock()
+			changes = append(changes, fmt.Sprintf("%v->%v", from, to))
+			lock.Unlock()
+		},
+	}))
+	defer pool.Destroy()
+	dial := pool.Dial
+	pool.Dial = func(addr string) (*goh.IdleClient, error) {
+		if atomic.LoadInt32(&failing) == 1 {
+			return nil, errors.New("refused")
+		}
+		return dial(addr)
+	}
+
+	get := func() error {
+		c, err := pool.Get()
+		if err == nil {
+			pool.Put(c)
+		}
+		return err
+	}
+
+	//two failed dials open the breaker, the gateway is not dialed any more
+	for i := 0; i < 2; i++ {
+		if err := get(); err == nil || errors.Is(err, goh.ErrBreakerOpen) {
+			t.Fatalf("dial %d: got %v, want the dial error", i, err)
+		}
+	}
+	err := get()
+	var open *goh.BreakerOpenError
+	if !errors.As(err, &open) || open.Addr != srv.Addr || !errors.Is(err, goh.ErrBreakerOpen) {
+		t.Fatalf("got %v, want a BreakerOpenError", err)
+	}
+	if state := pool.GetAddrStats()[0].State; state != goh.BreakerOpen {
+		t.Fatalf("breaker is %v, want open", state)
+	}
+
+	//a failed probe opens it again
+	time.Sleep(70 * time.Millisecond)
+	if err := get(); err == nil || errors.Is(err, goh.ErrBreakerOpen) {
+		t.Fatalf("half-open probe: got %v, want the dial error", err)
+	}
+	if err := get(); !errors.Is(err, goh.ErrBreakerOpen) {
+		t.Fatalf("got %v, want ErrBreakerOpen after the failed probe", err)
+	}
+
+	//the open timeout doubled, then a successful probe closes it
+	atomic.StoreInt32(&failing, 0)
+	time.Sleep(150 * time.Millisecond)
+	if err := get(); err != nil {
+		t.Fatalf("half-open probe: %v", err)
+	}
+	if state := pool.GetAddrStats()[0].State; state != goh.BreakerClosed {
+		t.Fatalf("breaker is %v, want closed", state)
+	}
+
+	lock.Lock()
+	defer lock.Unlock()
+	want := []string{"closed->open", "open->half-open", "half-open->open", "open->half-open", "half-open->closed"}
+	if !reflect.DeepEqual(changes, want) {
+		t.Fatalf("got the changes %v, want %v", changes, want)
+	}
+}
@@ -7,17 +7,53 @@ package gogohbase
 
 import (
 	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net"
+	"strings"
+
+	"git.apache.org/thrift.git/lib/go/thrift"
 	"github.com/blackbeans/gogobase/proto"
+	"github.com/blackbeans/gogobase/proto2"
 )
 
+/*
+error kinds, use errors.Is to test an error returned by HClient
+*/
+var (
+	ErrTableExists     = errors.New("Table already exists")
+	ErrTableNotFound   = errors.New("Table not found")
+	ErrScannerNotFound = errors.New("Scanner not found")
+	ErrTransport       = errors.New("Transport error")
+)
+
+/*
+IOError messages of the region server which mean the request can be sent again
+*/
+var retryableIOErrors = []string{
+	"NotServingRegionException",
+	"RegionMovedException",
+	"RegionOpeningException",
+	"RegionTooBusyException",
+	"ServerNotRunningYetException",
+	"PleaseHoldException",
+	"CallQueueTooBigException",
+	"ConnectionClosingException",
+	"SocketTimeoutException",
+	"RetriesExhaustedException",
+}
+
 /*
 HbaseError
 */
 type HbaseError struct {
-	IOErr  *proto.IOError         // IOError
-	ArgErr *proto.IllegalArgument // IllegalArgument
-	Err    error                  // error
+	IOErr         *proto.IOError         // IOError
+	ArgErr        *proto.IllegalArgument // IllegalArgument
+	AlreadyExists *proto.AlreadyExists   // AlreadyExists
+	Err           error                  // error
 
+	canRetry bool // the thrift2 server marked IOErr as retryable
 }
 
 func newHbaseError(arg *proto.IllegalArgument, err error) *HbaseError {
@@ -48,6 +84,12 @@ func (e *HbaseError) String() string {
 		b.WriteString(";")
 	}
 
+	if e.AlreadyExists != nil {
+		b.WriteString("AlreadyExists:")
+		b.WriteString(e.AlreadyExists.Message)
+		b.WriteString(";")
+	}
+
 	if e.Err != nil {
 		b.WriteString("Error:")
 		b.WriteString(e.Err.Error())
@@ -63,9 +105,150 @@ func (e *HbaseError) Error() string {
 	return e.String()
 }
 
+/*
+Unwrap returns the underlying transport or client error, if any
+*/
+func (e *HbaseError) Unwrap() error {
+	return e.Err
+}
+
+/*
+Is reports whether e is of the kind of one of the exported sentinels
+*/
+func (e *HbaseError) Is(target error) bool {
+	switch target {
+	case ErrTableExists:
+		return e.AlreadyExists != nil
+	case ErrTableNotFound:
+		return e.IOErr != nil && strings.Contains(e.IOErr.Message, "TableNotFoundException")
+	case ErrScannerNotFound:
+		return (e.ArgErr != nil && (strings.Contains(e.ArgErr.Message, "scanner ID is invalid") ||
+			strings.Contains(e.ArgErr.Message, "Invalid scanner Id"))) ||
+			(e.IOErr != nil && strings.Contains(e.IOErr.Message, "UnknownScannerException"))
+	case ErrTransport:
+		return isTransportError(e.Err)
+	}
+	return false
+}
+
+/*
+As finds the thrift exceptions carried by e
+*/
+func (e *HbaseError) As(target interface{}) bool {
+	switch t := target.(type) {
+	case **proto.IOError:
+		if e.IOErr != nil {
+			*t = e.IOErr
+			return true
+		}
+	case **proto.IllegalArgument:
+		if e.ArgErr != nil {
+			*t = e.ArgErr
+			return true
+		}
+	case **proto.AlreadyExists:
+		if e.AlreadyExists != nil {
+			*t = e.AlreadyExists
+			return true
+		}
+	}
+	return false
+}
+
+/*
+IsRetryable reports whether the call which returned err may succeed if it is
+sent again: transport failures and region server IOErrors caused by regions
+moving or servers being busy, or flagged canRetry by a thrift2 server.
+Illegal arguments, existing tables and
+cancelled or expired contexts are never retryable.
+*/
+func IsRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var he *HbaseError
+	if !errors.As(err, &he) {
+		return isTransportError(err)
+	}
+
+	if he.ArgErr != nil || he.AlreadyExists != nil {
+		return false
+	}
+
+	if he.IOErr != nil {
+		if he.canRetry {
+			return true
+		}
+		for _, name := range retryableIOErrors {
+			if strings.Contains(he.IOErr.Message, name) {
+				return true
+			}
+		}
+		return false
+	}
+
+	return isTransportError(he.Err)
+}
+
+/*
+isTransportError reports whether err broke the connection: the socket failed
+or the thrift stream is out of sync, so the client must not be reused.
+*/
+func isTransportError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	//context.DeadlineExceeded实现了net.Error,调用方的超时和取消不是传输层错误
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	if err == io.EOF || err == io.ErrUnexpectedEOF || err == ErrSocketDisconnect {
+		return true
+	}
+
+	switch e := err.(type) {
+	case thrift.TTransportException:
+		return true
+	case thrift.TProtocolException:
+		//写socket失败的错误会被包装成TProtocolException
+		return true
+	case thrift.TApplicationException:
+		switch e.TypeId() {
+		case thrift.BAD_SEQUENCE_ID, thrift.WRONG_METHOD_NAME, thrift.INVALID_MESSAGE_TYPE_EXCEPTION:
+			return true
+		}
+		return false
+	case net.Error:
+		return true
+	}
+	return false
+}
+
 func checkHbaseError(err error) error {
-	if err != nil {
-		return newHbaseError(nil, err)
+	if err == nil {
+		return nil
+	}
+
+	switch e := err.(type) {
+	case *HbaseError:
+		return e
+	case *proto.IOError:
+		return &HbaseError{IOErr: e}
+	case *proto.IllegalArgument:
+		return &HbaseError{ArgErr: e}
+	case *proto.AlreadyExists:
+		return &HbaseError{AlreadyExists: e}
+	case *proto2.TIOError:
+		return &HbaseError{IOErr: &proto.IOError{Message: e.GetMessage()}, canRetry: e.GetCanRetry()}
+	case *proto2.TIllegalArgument:
+		return &HbaseError{ArgErr: &proto.IllegalArgument{Message: e.GetMessage()}}
 	}
-	return nil
+	return newHbaseError(nil, err)
 }
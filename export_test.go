@@ -0,0 +1,38 @@
+package gogohbase
+
+import (
+	"sync"
+	"time"
+)
+
+// RetryBackoff exposes the backoff of policy to the tests
+func RetryBackoff(policy *RetryPolicy, attempt int) time.Duration {
+	return policy.backoff(attempt)
+}
+
+// clock replaces nowFunc once for the whole test binary, so that SetNow does not race
+// with the goroutines of the pools reading it
+var clock struct {
+	sync.Mutex
+	now func() time.Time
+}
+
+func init() {
+	nowFunc = func() time.Time {
+		clock.Lock()
+		now := clock.now
+		clock.Unlock()
+		if now == nil {
+			return time.Now()
+		}
+		return now()
+	}
+}
+
+// SetNow makes the pools read the time from now until restore is called
+func SetNow(now func() time.Time) (restore func()) {
+	clock.Lock()
+	clock.now = now
+	clock.Unlock()
+	return func() { SetNow(nil) }
+}
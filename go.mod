@@ -4,6 +4,5 @@ go 1.15
 
 require (
 	git.apache.org/thrift.git v0.0.0-20151001171628-53dd39833a08
-	github.com/blackbeans/log4go v0.0.0-20200623070814-a92daca2f0bb
-	github.com/prometheus/client_golang v1.11.0 // indirect
+	github.com/prometheus/client_golang v1.11.0
 )
@@ -0,0 +1,995 @@
+package hbasetest
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/blackbeans/gogobase/proto"
+)
+
+const latestTimestamp = math.MaxInt64
+
+/*
+cell is one version of a column
+*/
+type cell struct {
+	value []byte
+	ts    int64
+}
+
+/*
+table keeps the versions of every column of every row, newest first
+*/
+type table struct {
+	name     string
+	id       int64
+	enabled  bool
+	families map[string]*proto.ColumnDescriptor //family without ':'
+	rows     map[string]map[string][]cell       //row -> "family:qualifier" -> versions
+}
+
+type scanner struct {
+	rows []*proto.TRowResult_
+	pos  int
+}
+
+/*
+Fake is an in-memory HBase implementing the legacy thrift interface (proto.Hbase).
+Use it directly or serve it with NewServer.
+
+Filters (TScan.FilterString) are not supported.
+*/
+type Fake struct {
+	//clock of the cell timestamps, time.Now by default
+	Now func() time.Time
+
+	lock     sync.Mutex
+	tables   map[string]*table
+	scanners map[proto.ScannerID]*scanner
+	lastID   proto.ScannerID
+	lastTbl  int64
+
+	//region server reported by GetTableRegions
+	serverName string
+	port       int32
+}
+
+var _ proto.Hbase = (*Fake)(nil)
+
+/*
+NewFake returns an empty Fake
+*/
+func NewFake() *Fake {
+	return &Fake{
+		Now:        time.Now,
+		tables:     make(map[string]*table),
+		scanners:   make(map[proto.ScannerID]*scanner),
+		serverName: "localhost",
+	}
+}
+
+/*
+MustCreateTable creates an enabled table with families keeping 3 versions, it panics on error
+*/
+func (f *Fake) MustCreateTable(name string, families ...string) {
+	descs := make([]*proto.ColumnDescriptor, 0, len(families))
+	for _, family := range families {
+		desc := proto.NewColumnDescriptor()
+		desc.Name = proto.Text(family)
+		descs = append(descs, desc)
+	}
+
+	if err := f.CreateTable(proto.Text(name), descs); err != nil {
+		panic(err)
+	}
+}
+
+func (f *Fake) now() int64 {
+	return f.Now().UnixNano() / int64(time.Millisecond)
+}
+
+func ioError(format string, args ...interface{}) error {
+	return &proto.IOError{Message: fmt.Sprintf(format, args...)}
+}
+
+func illegalArgument(format string, args ...interface{}) error {
+	return &proto.IllegalArgument{Message: fmt.Sprintf(format, args...)}
+}
+
+/*
+table returns the table, which must exist
+*/
+func (f *Fake) table(name []byte) (*table, error) {
+	t, ok := f.tables[string(name)]
+	if !ok {
+		return nil, ioError("org.apache.hadoop.hbase.TableNotFoundException: %s", name)
+	}
+	return t, nil
+}
+
+/*
+dataTable returns the table, which must exist and be enabled
+*/
+func (f *Fake) dataTable(name []byte) (*table, error) {
+	t, err := f.table(name)
+	if err != nil {
+		return nil, err
+	}
+	if !t.enabled {
+		return nil, ioError("org.apache.hadoop.hbase.TableNotEnabledException: %s is disabled.", name)
+	}
+	return t, nil
+}
+
+/*
+parseColumn splits "family:qualifier" like KeyValue.parseColumn, hasQualifier
+is false if there is no ':'
+*/
+func parseColumn(column []byte) (family, qualifier string, hasQualifier bool) {
+	i := bytes.IndexByte(column, ':')
+	if i < 0 {
+		return string(column), "", false
+	}
+	return string(column[:i]), string(column[i+1:]), true
+}
+
+func (t *table) checkFamily(family string) error {
+	if _, ok := t.families[family]; !ok {
+		return ioError("org.apache.hadoop.hbase.regionserver.NoSuchColumnFamilyException: Column family %s does not exist in region %s", family, t.regionName())
+	}
+	return nil
+}
+
+func (t *table) regionName() string {
+	return fmt.Sprintf("%s,,%d", t.name, t.id)
+}
+
+/*
+writeColumn returns the stored name of the written column, "family" writes the empty qualifier
+*/
+func (t *table) writeColumn(column []byte) (string, error) {
+	family, qualifier, _ := parseColumn(column)
+	if err := t.checkFamily(family); err != nil {
+		return "", err
+	}
+	return family + ":" + qualifier, nil
+}
+
+/*
+columnSet selects the columns read, "family" or "family:" select the whole family
+*/
+type columnSet struct {
+	families map[string]bool
+	columns  map[string]bool
+}
+
+func (t *table) columnSet(columns [][]byte) (*columnSet, error) {
+	if len(columns) == 0 {
+		return nil, nil
+	}
+
+	s := &columnSet{families: make(map[string]bool), columns: make(map[string]bool)}
+	for _, column := range columns {
+		family, qualifier, _ := parseColumn(column)
+		if err := t.checkFamily(family); err != nil {
+			return nil, err
+		}
+		if qualifier == "" {
+			s.families[family] = true
+		} else {
+			s.columns[family+":"+qualifier] = true
+		}
+	}
+	return s, nil
+}
+
+func (s *columnSet) match(column string) bool {
+	if s == nil {
+		return true
+	}
+	if s.columns[column] {
+		return true
+	}
+	return s.families[column[:strings.IndexByte(column, ':')]]
+}
+
+/*
+put stores a version, replacing the one with the same timestamp, and drops the
+versions above the family's maxVersions
+*/
+func (t *table) put(row, column string, value []byte, ts int64) {
+	cols, ok := t.rows[row]
+	if !ok {
+		cols = make(map[string][]cell)
+		t.rows[row] = cols
+	}
+
+	versions := cols[column]
+	i := sort.Search(len(versions), func(i int) bool { return versions[i].ts <= ts })
+	c := cell{value: append([]byte(nil), value...), ts: ts}
+	if i < len(versions) && versions[i].ts == ts {
+		versions[i] = c
+	} else {
+		versions = append(versions, cell{})
+		copy(versions[i+1:], versions[i:])
+		versions[i] = c
+	}
+
+	family := column[:strings.IndexByte(column, ':')]
+	if max := int(t.families[family].MaxVersions); len(versions) > max {
+		versions = versions[:max]
+	}
+	cols[column] = versions
+}
+
+/*
+deleteColumns drops the versions of the matching columns with a timestamp <= ts
+*/
+func (t *table) deleteColumns(row string, match func(column string) bool, ts int64) {
+	cols, ok := t.rows[row]
+	if !ok {
+		return
+	}
+
+	for column, versions := range cols {
+		if !match(column) {
+			continue
+		}
+
+		kept := versions[:0]
+		for _, c := range versions {
+			if c.ts > ts {
+				kept = append(kept, c)
+			}
+		}
+		if len(kept) == 0 {
+			delete(cols, column)
+		} else {
+			cols[column] = kept
+		}
+	}
+
+	if len(cols) == 0 {
+		delete(t.rows, row)
+	}
+}
+
+/*
+versions returns at most n versions of the column older than maxTs
+*/
+func (t *table) versions(row, column string, maxTs int64, n int) []*proto.TCell {
+	var cells []*proto.TCell
+	for _, c := range t.rows[row][column] {
+		if len(cells) >= n {
+			break
+		}
+		if c.ts < maxTs {
+			cells = append(cells, &proto.TCell{Value: proto.Bytes(c.value), Timestamp: c.ts})
+		}
+	}
+	return cells
+}
+
+/*
+sortedColumns returns the names of the columns of a row in order
+*/
+func (t *table) sortedColumns(row string) []string {
+	cols := t.rows[row]
+	names := make([]string, 0, len(cols))
+	for column := range cols {
+		names = append(names, column)
+	}
+	sort.Strings(names)
+	return names
+}
+
+/*
+rowResult returns the latest version older than maxTs of the selected columns,
+nil if the row has none of them
+*/
+func (t *table) rowResult(row string, cols *columnSet, maxTs int64, sorted bool) *proto.TRowResult_ {
+	result := &proto.TRowResult_{Row: proto.Text(row)}
+	for _, column := range t.sortedColumns(row) {
+		if !cols.match(column) {
+			continue
+		}
+
+		cells := t.versions(row, column, maxTs, 1)
+		if len(cells) == 0 {
+			continue
+		}
+
+		if sorted {
+			result.SortedColumns = append(result.SortedColumns, &proto.TColumn{ColumnName: proto.Text(column), Cell: cells[0]})
+		} else {
+			if result.Columns == nil {
+				result.Columns = make(map[string]*proto.TCell)
+			}
+			result.Columns[column] = cells[0]
+		}
+	}
+
+	if result.Columns == nil && result.SortedColumns == nil {
+		return nil
+	}
+	return result
+}
+
+/*
+sortedRows returns the row keys of the table in order
+*/
+func (t *table) sortedRows() []string {
+	rows := make([]string, 0, len(t.rows))
+	for row := range t.rows {
+		rows = append(rows, row)
+	}
+	sort.Strings(rows)
+	return rows
+}
+
+func (t *table) regionInfo(f *Fake) *proto.TRegionInfo {
+	return &proto.TRegionInfo{
+		StartKey:   proto.Text{},
+		EndKey:     proto.Text{},
+		ID:         t.id,
+		Name:       proto.Text(t.regionName()),
+		Version:    1,
+		ServerName: proto.Text(f.serverName),
+		Port:       f.port,
+	}
+}
+
+// Brings a table on-line (enables it)
+func (f *Fake) EnableTable(tableName proto.Bytes) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	t, err := f.table(tableName)
+	if err != nil {
+		return err
+	}
+	t.enabled = true
+	return nil
+}
+
+// Disables a table (takes it off-line)
+func (f *Fake) DisableTable(tableName proto.Bytes) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	t, err := f.table(tableName)
+	if err != nil {
+		return err
+	}
+	t.enabled = false
+	return nil
+}
+
+// @return true if table is on-line
+func (f *Fake) IsTableEnabled(tableName proto.Bytes) (bool, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	t, err := f.table(tableName)
+	if err != nil {
+		return false, err
+	}
+	return t.enabled, nil
+}
+
+// Compaction is a no-op
+func (f *Fake) Compact(tableNameOrRegionName proto.Bytes) error {
+	return nil
+}
+
+// Compaction is a no-op
+func (f *Fake) MajorCompact(tableNameOrRegionName proto.Bytes) error {
+	return nil
+}
+
+// List all the userspace tables.
+func (f *Fake) GetTableNames() ([][]byte, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	names := make([]string, 0, len(f.tables))
+	for name := range f.tables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	data := make([][]byte, len(names))
+	for i, name := range names {
+		data[i] = []byte(name)
+	}
+	return data, nil
+}
+
+// List all the column families assoicated with a table.
+func (f *Fake) GetColumnDescriptors(tableName proto.Text) (map[string]*proto.ColumnDescriptor, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	t, err := f.table(tableName)
+	if err != nil {
+		return nil, err
+	}
+
+	descs := make(map[string]*proto.ColumnDescriptor, len(t.families))
+	for family, desc := range t.families {
+		d := *desc
+		d.Name = proto.Text(family + ":")
+		descs[family+":"] = &d
+	}
+	return descs, nil
+}
+
+// List the regions associated with a table, a table of the Fake has a single region.
+func (f *Fake) GetTableRegions(tableName proto.Text) ([]*proto.TRegionInfo, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	t, err := f.table(tableName)
+	if err != nil {
+		return nil, err
+	}
+	return []*proto.TRegionInfo{t.regionInfo(f)}, nil
+}
+
+// Create a table with the specified column families.
+func (f *Fake) CreateTable(tableName proto.Text, columnFamilies []*proto.ColumnDescriptor) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if len(tableName) == 0 {
+		return illegalArgument("table name is empty")
+	}
+	if _, ok := f.tables[string(tableName)]; ok {
+		return &proto.AlreadyExists{Message: "table name already in use"}
+	}
+	if len(columnFamilies) == 0 {
+		return illegalArgument("Table should have at least one column family.")
+	}
+
+	families := make(map[string]*proto.ColumnDescriptor, len(columnFamilies))
+	for _, desc := range columnFamilies {
+		family, _, _ := parseColumn(desc.Name)
+		if family == "" {
+			return illegalArgument("Family name can not be empty")
+		}
+		if desc.MaxVersions <= 0 {
+			return illegalArgument("Maximum versions must be positive")
+		}
+		d := *desc
+		families[family] = &d
+	}
+
+	f.lastTbl++
+	f.tables[string(tableName)] = &table{
+		name:     string(tableName),
+		id:       f.lastTbl,
+		enabled:  true,
+		families: families,
+		rows:     make(map[string]map[string][]cell),
+	}
+	return nil
+}
+
+// Deletes a table, which must be disabled
+func (f *Fake) DeleteTable(tableName proto.Text) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	t, err := f.table(tableName)
+	if err != nil {
+		return err
+	}
+	if t.enabled {
+		return ioError("org.apache.hadoop.hbase.TableNotDisabledException: %s", tableName)
+	}
+	delete(f.tables, t.name)
+	return nil
+}
+
+// Get a single TCell for the specified table, row, and column at the latest timestamp.
+func (f *Fake) Get(tableName proto.Text, row proto.Text, column proto.Text, attributes map[string]proto.Text) ([]*proto.TCell, error) {
+	return f.GetVerTs(tableName, row, column, latestTimestamp, 1, attributes)
+}
+
+// Get the specified number of versions for the specified table, row, and column.
+func (f *Fake) GetVer(tableName proto.Text, row proto.Text, column proto.Text, numVersions int32, attributes map[string]proto.Text) ([]*proto.TCell, error) {
+	return f.GetVerTs(tableName, row, column, latestTimestamp, numVersions, attributes)
+}
+
+// Get the specified number of versions older than timestamp for the specified table, row, and column.
+func (f *Fake) GetVerTs(tableName proto.Text, row proto.Text, column proto.Text, timestamp int64, numVersions int32, attributes map[string]proto.Text) ([]*proto.TCell, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	t, err := f.dataTable(tableName)
+	if err != nil {
+		return nil, err
+	}
+
+	cols, err := t.columnSet([][]byte{column})
+	if err != nil {
+		return nil, err
+	}
+
+	var cells []*proto.TCell
+	for _, name := range t.sortedColumns(string(row)) {
+		if cols.match(name) {
+			cells = append(cells, t.versions(string(row), name, timestamp, int(numVersions))...)
+		}
+	}
+	return cells, nil
+}
+
+// Get all the data for the specified table and row at the latest timestamp.
+func (f *Fake) GetRow(tableName proto.Text, row proto.Text, attributes map[string]proto.Text) ([]*proto.TRowResult_, error) {
+	return f.GetRowsWithColumnsTs(tableName, [][]byte{row}, nil, latestTimestamp, attributes)
+}
+
+// Get the specified columns for the specified table and row at the latest timestamp.
+func (f *Fake) GetRowWithColumns(tableName proto.Text, row proto.Text, columns [][]byte, attributes map[string]proto.Text) ([]*proto.TRowResult_, error) {
+	return f.GetRowsWithColumnsTs(tableName, [][]byte{row}, columns, latestTimestamp, attributes)
+}
+
+// Get all the data for the specified table and row older than timestamp.
+func (f *Fake) GetRowTs(tableName proto.Text, row proto.Text, timestamp int64, attributes map[string]proto.Text) ([]*proto.TRowResult_, error) {
+	return f.GetRowsWithColumnsTs(tableName, [][]byte{row}, nil, timestamp, attributes)
+}
+
+// Get the specified columns for the specified table and row older than timestamp.
+func (f *Fake) GetRowWithColumnsTs(tableName proto.Text, row proto.Text, columns [][]byte, timestamp int64, attributes map[string]proto.Text) ([]*proto.TRowResult_, error) {
+	return f.GetRowsWithColumnsTs(tableName, [][]byte{row}, columns, timestamp, attributes)
+}
+
+// Get all the data for the specified table and rows at the latest timestamp.
+func (f *Fake) GetRows(tableName proto.Text, rows [][]byte, attributes map[string]proto.Text) ([]*proto.TRowResult_, error) {
+	return f.GetRowsWithColumnsTs(tableName, rows, nil, latestTimestamp, attributes)
+}
+
+// Get the specified columns for the specified table and rows at the latest timestamp.
+func (f *Fake) GetRowsWithColumns(tableName proto.Text, rows [][]byte, columns [][]byte, attributes map[string]proto.Text) ([]*proto.TRowResult_, error) {
+	return f.GetRowsWithColumnsTs(tableName, rows, columns, latestTimestamp, attributes)
+}
+
+// Get all the data for the specified table and rows older than timestamp.
+func (f *Fake) GetRowsTs(tableName proto.Text, rows [][]byte, timestamp int64, attributes map[string]proto.Text) ([]*proto.TRowResult_, error) {
+	return f.GetRowsWithColumnsTs(tableName, rows, nil, timestamp, attributes)
+}
+
+// Get the specified columns for the specified table and rows older than timestamp.
+// Rows which are not found are left out of the result.
+func (f *Fake) GetRowsWithColumnsTs(tableName proto.Text, rows [][]byte, columns [][]byte, timestamp int64, attributes map[string]proto.Text) ([]*proto.TRowResult_, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	t, err := f.dataTable(tableName)
+	if err != nil {
+		return nil, err
+	}
+
+	cols, err := t.columnSet(columns)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*proto.TRowResult_, 0, len(rows))
+	for _, row := range rows {
+		if result := t.rowResult(string(row), cols, timestamp, false); result != nil {
+			results = append(results, result)
+		}
+	}
+	return results, nil
+}
+
+// Apply a series of mutations (updates/deletes) to a row in a single transaction.
+func (f *Fake) MutateRow(tableName proto.Text, row proto.Text, mutations []*proto.Mutation, attributes map[string]proto.Text) error {
+	return f.MutateRowsTs(tableName, []*proto.BatchMutation{{Row: row, Mutations: mutations}}, latestTimestamp, attributes)
+}
+
+// Apply a series of mutations (updates/deletes) to a row in a single transaction at timestamp.
+func (f *Fake) MutateRowTs(tableName proto.Text, row proto.Text, mutations []*proto.Mutation, timestamp int64, attributes map[string]proto.Text) error {
+	return f.MutateRowsTs(tableName, []*proto.BatchMutation{{Row: row, Mutations: mutations}}, timestamp, attributes)
+}
+
+// Apply a series of batches (each a series of mutations on a single row) in a single transaction.
+func (f *Fake) MutateRows(tableName proto.Text, rowBatches []*proto.BatchMutation, attributes map[string]proto.Text) error {
+	return f.MutateRowsTs(tableName, rowBatches, latestTimestamp, attributes)
+}
+
+// Apply a series of batches at timestamp. The deletes of a row are applied before its puts,
+// and nothing is applied if a column family does not exist.
+func (f *Fake) MutateRowsTs(tableName proto.Text, rowBatches []*proto.BatchMutation, timestamp int64, attributes map[string]proto.Text) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	t, err := f.dataTable(tableName)
+	if err != nil {
+		return err
+	}
+
+	for _, batch := range rowBatches {
+		for _, m := range batch.Mutations {
+			family, _, _ := parseColumn(m.Column)
+			if err := t.checkFamily(family); err != nil {
+				return err
+			}
+		}
+	}
+
+	putTs := timestamp
+	if putTs == latestTimestamp {
+		putTs = f.now()
+	}
+
+	for _, batch := range rowBatches {
+		row := string(batch.Row)
+		for _, m := range batch.Mutations {
+			if !m.IsDelete {
+				continue
+			}
+
+			family, qualifier, hasQualifier := parseColumn(m.Column)
+			if hasQualifier {
+				column := family + ":" + qualifier
+				t.deleteColumns(row, func(c string) bool { return c == column }, timestamp)
+			} else {
+				t.deleteColumns(row, func(c string) bool { return strings.HasPrefix(c, family+":") }, timestamp)
+			}
+		}
+
+		for _, m := range batch.Mutations {
+			if m.IsDelete {
+				continue
+			}
+
+			column, _ := t.writeColumn(m.Column)
+			t.put(row, column, m.Value, putTs)
+		}
+	}
+	return nil
+}
+
+// Atomically increment the column value specified, the value must be an 8 bytes long.
+func (f *Fake) AtomicIncrement(tableName proto.Text, row proto.Text, column proto.Text, value int64) (int64, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	t, err := f.dataTable(tableName)
+	if err != nil {
+		return 0, err
+	}
+	return f.increment(t, string(row), column, value)
+}
+
+func (f *Fake) increment(t *table, row string, column []byte, amount int64) (int64, error) {
+	name, err := t.writeColumn(column)
+	if err != nil {
+		return 0, err
+	}
+
+	var current int64
+	if cells := t.versions(row, name, latestTimestamp, 1); len(cells) > 0 {
+		if len(cells[0].Value) != 8 {
+			return 0, ioError("org.apache.hadoop.hbase.DoNotRetryIOException: Field is not a long, it's %d bytes wide", len(cells[0].Value))
+		}
+		current = int64(binary.BigEndian.Uint64(cells[0].Value))
+	}
+
+	current += amount
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(current))
+	t.put(row, name, b, f.now())
+	return current, nil
+}
+
+// Delete all cells that match the passed row and column.
+func (f *Fake) DeleteAll(tableName proto.Text, row proto.Text, column proto.Text, attributes map[string]proto.Text) error {
+	return f.DeleteAllTs(tableName, row, column, latestTimestamp, attributes)
+}
+
+// Delete all cells that match the passed row and column and whose timestamp is <= timestamp.
+func (f *Fake) DeleteAllTs(tableName proto.Text, row proto.Text, column proto.Text, timestamp int64, attributes map[string]proto.Text) error {
+	return f.MutateRowsTs(tableName, []*proto.BatchMutation{{Row: row, Mutations: []*proto.Mutation{{IsDelete: true, Column: column}}}}, timestamp, attributes)
+}
+
+// Completely delete the row's cells.
+func (f *Fake) DeleteAllRow(tableName proto.Text, row proto.Text, attributes map[string]proto.Text) error {
+	return f.DeleteAllRowTs(tableName, row, latestTimestamp, attributes)
+}
+
+// Completely delete the row's cells whose timestamp is <= timestamp.
+func (f *Fake) DeleteAllRowTs(tableName proto.Text, row proto.Text, timestamp int64, attributes map[string]proto.Text) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	t, err := f.dataTable(tableName)
+	if err != nil {
+		return err
+	}
+	t.deleteColumns(string(row), func(string) bool { return true }, timestamp)
+	return nil
+}
+
+// Increment a cell by the ammount.
+func (f *Fake) Increment(increment *proto.TIncrement) error {
+	return f.IncrementRows([]*proto.TIncrement{increment})
+}
+
+// Increment cells by the ammount.
+func (f *Fake) IncrementRows(increments []*proto.TIncrement) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	for _, inc := range increments {
+		t, err := f.dataTable(inc.Table)
+		if err != nil {
+			return err
+		}
+		if _, err = f.increment(t, string(inc.Row), inc.Column, inc.Ammount); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Get a scanner on the current table, using the Scan instance for the scan parameters.
+// The rows are read when the scanner is opened.
+func (f *Fake) ScannerOpenWithScan(tableName proto.Text, scan *proto.TScan, attributes map[string]proto.Text) (proto.ScannerID, error) {
+	if scan == nil {
+		scan = proto.NewTScan()
+	}
+	if len(scan.FilterString) > 0 {
+		return 0, illegalArgument("hbasetest: filters are not supported")
+	}
+
+	timestamp := int64(latestTimestamp)
+	if scan.IsSetTimestamp() {
+		timestamp = scan.GetTimestamp()
+	}
+	return f.openScanner(tableName, scan.StartRow, scan.StopRow, nil, scan.Columns, timestamp, scan.GetSortColumns(), scan.GetReversed())
+}
+
+// Get a scanner on the current table starting at the specified row.
+func (f *Fake) ScannerOpen(tableName proto.Text, startRow proto.Text, columns [][]byte, attributes map[string]proto.Text) (proto.ScannerID, error) {
+	return f.openScanner(tableName, startRow, nil, nil, columns, latestTimestamp, false, false)
+}
+
+// Get a scanner on the current table starting and stopping at the specified rows.
+func (f *Fake) ScannerOpenWithStop(tableName proto.Text, startRow proto.Text, stopRow proto.Text, columns [][]byte, attributes map[string]proto.Text) (proto.ScannerID, error) {
+	return f.openScanner(tableName, startRow, stopRow, nil, columns, latestTimestamp, false, false)
+}
+
+// Open a scanner for a given prefix.
+func (f *Fake) ScannerOpenWithPrefix(tableName proto.Text, startAndPrefix proto.Text, columns [][]byte, attributes map[string]proto.Text) (proto.ScannerID, error) {
+	return f.openScanner(tableName, startAndPrefix, nil, startAndPrefix, columns, latestTimestamp, false, false)
+}
+
+// Get a scanner on the current table starting at the specified row, only cells older than timestamp are returned.
+func (f *Fake) ScannerOpenTs(tableName proto.Text, startRow proto.Text, columns [][]byte, timestamp int64, attributes map[string]proto.Text) (proto.ScannerID, error) {
+	return f.openScanner(tableName, startRow, nil, nil, columns, timestamp, false, false)
+}
+
+// Get a scanner on the current table starting and stopping at the specified rows, only cells older than timestamp are returned.
+func (f *Fake) ScannerOpenWithStopTs(tableName proto.Text, startRow proto.Text, stopRow proto.Text, columns [][]byte, timestamp int64, attributes map[string]proto.Text) (proto.ScannerID, error) {
+	return f.openScanner(tableName, startRow, stopRow, nil, columns, timestamp, false, false)
+}
+
+func (f *Fake) openScanner(tableName, startRow, stopRow, prefix []byte, columns [][]byte, timestamp int64, sorted, reversed bool) (proto.ScannerID, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	t, err := f.dataTable(tableName)
+	if err != nil {
+		return 0, err
+	}
+
+	cols, err := t.columnSet(columns)
+	if err != nil {
+		return 0, err
+	}
+
+	rows := t.sortedRows()
+	if reversed {
+		for i, j := 0, len(rows)-1; i < j; i, j = i+1, j-1 {
+			rows[i], rows[j] = rows[j], rows[i]
+		}
+	}
+
+	s := &scanner{}
+	for _, row := range rows {
+		if !inRange(row, startRow, stopRow, reversed) || !strings.HasPrefix(row, string(prefix)) {
+			continue
+		}
+		if result := t.rowResult(row, cols, timestamp, sorted); result != nil {
+			s.rows = append(s.rows, result)
+		}
+	}
+
+	f.lastID++
+	f.scanners[f.lastID] = s
+	return f.lastID, nil
+}
+
+/*
+inRange reports whether row is in [start, stop), or in (stop, start] for reversed scans
+*/
+func inRange(row string, start, stop []byte, reversed bool) bool {
+	if reversed {
+		return (len(start) == 0 || row <= string(start)) && (len(stop) == 0 || row > string(stop))
+	}
+	return (len(start) == 0 || row >= string(start)) && (len(stop) == 0 || row < string(stop))
+}
+
+// Returns the scanner's current row value and advances to the next row in the table.
+func (f *Fake) ScannerGet(id proto.ScannerID) ([]*proto.TRowResult_, error) {
+	return f.ScannerGetList(id, 1)
+}
+
+// Returns, starting at the scanner's current row value nbRows worth of rows
+// and advances to the next row in the table. An empty list means the scanner is exhausted.
+func (f *Fake) ScannerGetList(id proto.ScannerID, nbRows int32) ([]*proto.TRowResult_, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	s, ok := f.scanners[id]
+	if !ok {
+		return nil, illegalArgument("scanner ID is invalid")
+	}
+
+	end := s.pos + int(nbRows)
+	if end > len(s.rows) {
+		end = len(s.rows)
+	}
+	if end < s.pos {
+		end = s.pos
+	}
+
+	rows := s.rows[s.pos:end]
+	s.pos = end
+	return rows, nil
+}
+
+// Closes the server-state associated with an open scanner.
+func (f *Fake) ScannerClose(id proto.ScannerID) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if _, ok := f.scanners[id]; !ok {
+		return illegalArgument("scanner ID is invalid")
+	}
+	delete(f.scanners, id)
+	return nil
+}
+
+// Get the row just before the specified one which has cells in family.
+func (f *Fake) GetRowOrBefore(tableName proto.Text, row proto.Text, family proto.Text) ([]*proto.TCell, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	t, err := f.dataTable(tableName)
+	if err != nil {
+		return nil, err
+	}
+
+	cols, err := t.columnSet([][]byte{family})
+	if err != nil {
+		return nil, err
+	}
+
+	rows := t.sortedRows()
+	for i := len(rows) - 1; i >= 0; i-- {
+		if rows[i] > string(row) {
+			continue
+		}
+
+		var cells []*proto.TCell
+		for _, column := range t.sortedColumns(rows[i]) {
+			if cols.match(column) {
+				cells = append(cells, t.versions(rows[i], column, latestTimestamp, 1)...)
+			}
+		}
+		if len(cells) > 0 {
+			return cells, nil
+		}
+	}
+	return nil, nil
+}
+
+// Get the regininfo for the specified row ("table,row,..." as in hbase:meta).
+func (f *Fake) GetRegionInfo(row proto.Text) (*proto.TRegionInfo, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	name := row
+	if i := bytes.IndexByte(row, ','); i >= 0 {
+		name = row[:i]
+	}
+
+	t, err := f.table(name)
+	if err != nil {
+		return nil, err
+	}
+	return t.regionInfo(f), nil
+}
+
+// Appends values to one or more columns within a single row.
+func (f *Fake) Append(append_ *proto.TAppend) ([]*proto.TCell, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	t, err := f.dataTable(append_.Table)
+	if err != nil {
+		return nil, err
+	}
+	if len(append_.Columns) != len(append_.Values) {
+		return nil, illegalArgument("columns and values have different lengths")
+	}
+
+	names := make([]string, len(append_.Columns))
+	for i, column := range append_.Columns {
+		if names[i], err = t.writeColumn(column); err != nil {
+			return nil, err
+		}
+	}
+
+	row := string(append_.Row)
+	ts := f.now()
+	cells := make([]*proto.TCell, 0, len(names))
+	for i, name := range names {
+		var value []byte
+		if current := t.versions(row, name, latestTimestamp, 1); len(current) > 0 {
+			value = append(value, current[0].Value...)
+		}
+		value = append(value, append_.Values[i]...)
+
+		t.put(row, name, value, ts)
+		cells = append(cells, &proto.TCell{Value: proto.Bytes(value), Timestamp: ts})
+	}
+	return cells, nil
+}
+
+// Atomically checks if a row/family/qualifier value matches the expected value,
+// an empty value matches a missing column. If it does, it adds the Put.
+func (f *Fake) CheckAndPut(tableName proto.Text, row proto.Text, column proto.Text, value proto.Text, mput *proto.Mutation, attributes map[string]proto.Text) (bool, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	t, err := f.dataTable(tableName)
+	if err != nil {
+		return false, err
+	}
+	if mput == nil {
+		return false, illegalArgument("mput is null")
+	}
+
+	checked, err := t.writeColumn(column)
+	if err != nil {
+		return false, err
+	}
+	put, err := t.writeColumn(mput.Column)
+	if err != nil {
+		return false, err
+	}
+
+	current := t.versions(string(row), checked, latestTimestamp, 1)
+	if len(value) == 0 {
+		if len(current) > 0 && len(current[0].Value) > 0 {
+			return false, nil
+		}
+	} else if len(current) == 0 || !bytes.Equal(current[0].Value, value) {
+		return false, nil
+	}
+
+	t.put(string(row), put, mput.Value, f.now())
+	return true, nil
+}
@@ -0,0 +1,163 @@
+/*
+Package hbasetest provides an in-memory HBase thrift server for tests,
+in the spirit of net/http/httptest.
+
+	srv := hbasetest.NewServer()
+	defer srv.Close()
+	srv.Fake.MustCreateTable("test", "cf")
+
+	client, err := gogohbase.NewTcpClient(srv.Addr, gogohbase.TBinaryProtocol, false)
+	...
+	err = client.Open()
+*/
+package hbasetest
+
+import (
+	"fmt"
+	"net"
+	"sync"
+
+	"git.apache.org/thrift.git/lib/go/thrift"
+	"github.com/blackbeans/gogobase"
+	"github.com/blackbeans/gogobase/proto"
+)
+
+/*
+Server serves a Fake over thrift on a loopback port
+*/
+type Server struct {
+	Addr string //host:port of the server
+	Fake *Fake
+
+	transport *serverTransport
+	server    *thrift.TSimpleServer
+}
+
+/*
+NewServer starts a server speaking the binary protocol on an unframed transport,
+the default of the HBase thrift server
+*/
+func NewServer() *Server {
+	return NewServerProtocol(gogohbase.TBinaryProtocol, false)
+}
+
+/*
+NewServerProtocol starts a server speaking protocol (gogohbase.TBinaryProtocol,
+TCompactProtocol or TJSONProtocol), it panics if the server cannot listen
+*/
+func NewServerProtocol(protocol int, framed bool) *Server {
+	var protocolFactory thrift.TProtocolFactory
+	switch protocol {
+	case gogohbase.TBinaryProtocol:
+		protocolFactory = thrift.NewTBinaryProtocolFactoryDefault()
+	case gogohbase.TCompactProtocol:
+		protocolFactory = thrift.NewTCompactProtocolFactory()
+	case gogohbase.TJSONProtocol:
+		protocolFactory = thrift.NewTJSONProtocolFactory()
+	default:
+		panic(fmt.Sprint("hbasetest: invalid protocol:", protocol))
+	}
+
+	transportFactory := thrift.NewTTransportFactory()
+	if framed {
+		transportFactory = thrift.NewTFramedTransportFactory(transportFactory)
+	}
+
+	socket, err := thrift.NewTServerSocket("127.0.0.1:0")
+	if err != nil {
+		panic(fmt.Sprintf("hbasetest: failed to listen: %v", err))
+	}
+	transport := &serverTransport{TServerSocket: socket, conns: make(map[thrift.TTransport]net.Conn)}
+
+	fake := NewFake()
+	server := thrift.NewTSimpleServer4(proto.NewHbaseProcessor(fake), transport, transportFactory, protocolFactory)
+	if err := server.Listen(); err != nil {
+		panic(fmt.Sprintf("hbasetest: failed to listen: %v", err))
+	}
+
+	addr := socket.Addr().(*net.TCPAddr)
+	fake.port = int32(addr.Port)
+	go server.AcceptLoop()
+
+	return &Server{
+		Addr:      addr.String(),
+		Fake:      fake,
+		transport: transport,
+		server:    server,
+	}
+}
+
+/*
+CloseClientConnections closes the accepted connections, the clients see a
+transport error on their next call
+*/
+func (s *Server) CloseClientConnections() {
+	s.transport.closeConns()
+}
+
+/*
+Close stops accepting connections and closes the accepted ones
+*/
+func (s *Server) Close() {
+	s.server.Stop()
+	s.transport.Close()
+	s.transport.closeConns()
+}
+
+/*
+serverTransport keeps track of the accepted connections so that they can be closed
+*/
+type serverTransport struct {
+	*thrift.TServerSocket
+
+	lock  sync.Mutex
+	conns map[thrift.TTransport]net.Conn
+}
+
+func (t *serverTransport) Accept() (thrift.TTransport, error) {
+	conn, err := t.TServerSocket.Accept()
+	if err != nil {
+		return nil, err
+	}
+
+	var raw net.Conn
+	if socket, ok := conn.(*thrift.TSocket); ok {
+		raw = socket.Conn()
+	}
+
+	t.lock.Lock()
+	t.conns[conn] = raw
+	t.lock.Unlock()
+	return &serverConn{TTransport: conn, owner: t}, nil
+}
+
+func (t *serverTransport) closeConns() {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	// closing the net.Conn is safe while the server reads it, the server
+	// then closes the transport itself
+	for conn, raw := range t.conns {
+		if raw != nil {
+			raw.Close()
+		} else {
+			conn.Close()
+		}
+		delete(t.conns, conn)
+	}
+}
+
+/*
+serverConn forgets the connection once the server is done with it
+*/
+type serverConn struct {
+	thrift.TTransport
+	owner *serverTransport
+}
+
+func (c *serverConn) Close() error {
+	c.owner.lock.Lock()
+	delete(c.owner.conns, c.TTransport)
+	c.owner.lock.Unlock()
+	return c.TTransport.Close()
+}
@@ -1,6 +1,12 @@
 package gogohbase
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
 	"git.apache.org/thrift.git/lib/go/thrift"
 	"github.com/blackbeans/gogobase/proto"
 
@@ -12,14 +18,26 @@ import (
 HClient is wrap of Hbase client
 */
 type HClient struct {
+	thriftConn
+	hbase *proto.HbaseClient
+}
+
+/*
+thriftConn is the transport shared by HClient and HClient2
+*/
+type thriftConn struct {
 	//Host            string
 	//Port            int
 	addr            string
 	Protocol        int
 	Trans           thrift.TTransport
 	ProtocolFactory thrift.TProtocolFactory
-	hbase           *proto.HbaseClient
-	state           int //
+	state           int             //
+	socket          *thrift.TSocket //underlying socket of tcp clients, nil for http
+	http            *httpTransport  //transport of http clients, nil for tcp
+	timeout         time.Duration   //socket read/write timeout, 0 means none
+	observe         rpcObserver     //records the rpc metrics, set by the pool
+	nonIdempotent   bool            //a non idempotent rpc was sent, see RetryPolicy
 }
 
 /*
@@ -27,17 +45,17 @@ NewHttpClient return a Hbase http client instance
 
 */
 func NewHttpClient(rawurl string, protocol int) (client *HClient, err error) {
-	parsedUrl, err := url.Parse(rawurl)
+	addr, trans, err := newHttpTransport(rawurl)
 	if err != nil {
 		return
 	}
 
-	trans, err := thrift.NewTHttpClient(parsedUrl.String())
+	client, err = newClient(addr, protocol, trans)
 	if err != nil {
 		return
 	}
-
-	return newClient(parsedUrl.String(), protocol, trans)
+	client.http = trans
+	return
 }
 
 /*
@@ -45,16 +63,41 @@ NewTcpClient return a base tcp client instance
 
 */
 func NewTcpClient(rawaddr string, protocol int, framed bool) (client *HClient, err error) {
-	var trans thrift.TTransport
-	trans, err = thrift.NewTSocket(rawaddr)
+	socket, trans, err := newTcpTransport(rawaddr, framed)
 	if err != nil {
 		return
 	}
+
+	client, err = newClient(rawaddr, protocol, trans)
+	if err != nil {
+		return
+	}
+	client.socket = socket
+	return
+}
+
+func newHttpTransport(rawurl string) (string, *httpTransport, error) {
+	parsedUrl, err := url.Parse(rawurl)
+	if err != nil {
+		return "", nil, err
+	}
+	return parsedUrl.String(), newHttpPostTransport(parsedUrl.String()), nil
+}
+
+/*
+newTcpTransport returns the socket and the transport wrapping it
+*/
+func newTcpTransport(rawaddr string, framed bool) (*thrift.TSocket, thrift.TTransport, error) {
+	socket, err := thrift.NewTSocket(rawaddr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var trans thrift.TTransport = socket
 	if framed {
 		trans = thrift.NewTFramedTransport(trans)
 	}
-
-	return newClient(rawaddr, protocol, trans)
+	return socket, trans, nil
 }
 
 /*
@@ -69,11 +112,13 @@ func newClient(addr string, protocol int, trans thrift.TTransport) (*HClient, er
 	}
 
 	client = &HClient{
-		addr:            addr,
-		Protocol:        protocol,
-		ProtocolFactory: protocolFactory,
-		Trans:           trans,
-		hbase:           proto.NewHbaseClientFactory(trans, protocolFactory),
+		thriftConn: thriftConn{
+			addr:            addr,
+			Protocol:        protocol,
+			ProtocolFactory: protocolFactory,
+			Trans:           trans,
+		},
+		hbase: proto.NewHbaseClientFactory(trans, protocolFactory),
 	}
 
 	// if err = client.Open(); err != nil {
@@ -86,7 +131,7 @@ func newClient(addr string, protocol int, trans thrift.TTransport) (*HClient, er
 /*
 Open connection
 */
-func (client *HClient) Open() error {
+func (client *thriftConn) Open() error {
 	if client.state == stateDefault {
 		if err := client.Trans.Open(); err != nil {
 			return err
@@ -99,14 +144,14 @@ func (client *HClient) Open() error {
 /**
 Is Client Alive
 */
-func (client *HClient) IsAlive() bool {
+func (client *thriftConn) IsAlive() bool {
 	return client.state == stateOpen
 }
 
 /*
 Close connection
 */
-func (client *HClient) Close() error {
+func (client *thriftConn) Close() error {
 	if client.state == stateOpen {
 		if err := client.Trans.Close(); err != nil {
 			return err
@@ -116,6 +161,126 @@ func (client *HClient) Close() error {
 	return nil
 }
 
+/*
+SetTimeout set the read/write timeout of the socket, 0 means no timeout.
+It bounds each request with its response on http clients.
+*/
+func (client *thriftConn) SetTimeout(timeout time.Duration) {
+	client.timeout = timeout
+	if client.socket != nil {
+		client.socket.SetTimeout(timeout)
+	}
+	if client.http != nil {
+		client.http.timeout = timeout
+	}
+}
+
+/*
+call runs the rpc and reports it to the observer, method and table label the metrics
+*/
+func (client *thriftConn) call(ctx context.Context, method, table string, rpc func() error) error {
+	if nonIdempotentRPCs[method] {
+		client.nonIdempotent = true
+	}
+
+	if client.observe == nil {
+		return client.invoke(ctx, rpc)
+	}
+
+	start := time.Now()
+	err := client.invoke(ctx, rpc)
+	client.observe(method, table, time.Since(start), err)
+	return err
+}
+
+/*
+invoke runs the rpc bounded by ctx. The socket timeout is shortened to the
+remaining time before the deadline, and the connection is closed as soon as
+ctx is done so a blocked read returns; the requests of http clients carry ctx.
+A client interrupted this way is no longer alive, so the pool never reuses a
+half-read transport.
+*/
+func (client *thriftConn) invoke(ctx context.Context, rpc func() error) error {
+	if ctx == nil || ctx.Done() == nil {
+		return rpc()
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	shortened := false
+	if deadline, ok := ctx.Deadline(); ok && client.socket != nil {
+		remain := deadline.Sub(time.Now())
+		if remain <= 0 {
+			return context.DeadlineExceeded
+		}
+
+		if client.timeout <= 0 || remain < client.timeout {
+			shortened = true
+			client.socket.SetTimeout(remain)
+			defer client.socket.SetTimeout(client.timeout)
+		}
+	}
+
+	var conn net.Conn
+	if client.socket != nil {
+		conn = client.socket.Conn()
+	}
+	//http请求由ctx取消
+	if client.http != nil {
+		client.http.ctx = ctx
+		defer func() { client.http.ctx = nil }()
+	}
+
+	stop := make(chan struct{})
+	interrupted := make(chan bool, 1)
+	go func() {
+		select {
+		case <-ctx.Done():
+			if conn != nil {
+				conn.Close()
+			}
+			interrupted <- true
+		case <-stop:
+			interrupted <- false
+		}
+	}()
+
+	err := rpc()
+	close(stop)
+
+	if <-interrupted {
+		client.invalidate()
+		if err != nil {
+			return ctx.Err()
+		}
+		return nil
+	}
+
+	//the shortened socket timeout fired just before ctx did
+	if err != nil && shortened && isTimeout(err) {
+		client.invalidate()
+		return context.DeadlineExceeded
+	}
+	return err
+}
+
+/*
+invalidate closes the transport after an interrupted rpc
+*/
+func (client *thriftConn) invalidate() {
+	client.Trans.Close()
+	client.state = stateDefault
+}
+
+func isTimeout(err error) bool {
+	if e, ok := err.(thrift.TTransportException); ok {
+		return e.TypeId() == thrift.TIMED_OUT
+	}
+	return false
+}
+
 /**
  * Brings a table on-line (enables it)
  *
@@ -123,7 +288,16 @@ func (client *HClient) Close() error {
  *  - TableName: name of the table
  */
 func (client *HClient) EnableTable(tableName string) error {
-	return checkHbaseError(client.hbase.EnableTable(proto.Bytes(tableName)))
+	return client.EnableTableCtx(context.Background(), tableName)
+}
+
+/**
+ * EnableTableCtx is like EnableTable but honors the deadline and cancellation of ctx.
+ */
+func (client *HClient) EnableTableCtx(ctx context.Context, tableName string) error {
+	return checkHbaseError(client.call(ctx, "EnableTable", tableName, func() error {
+		return client.hbase.EnableTable(proto.Bytes(tableName))
+	}))
 }
 
 /**
@@ -134,7 +308,16 @@ func (client *HClient) EnableTable(tableName string) error {
  *  - TableName: name of the table
  */
 func (client *HClient) DisableTable(tableName string) (err error) {
-	return checkHbaseError(client.hbase.DisableTable(proto.Bytes(tableName)))
+	return client.DisableTableCtx(context.Background(), tableName)
+}
+
+/**
+ * DisableTableCtx is like DisableTable but honors the deadline and cancellation of ctx.
+ */
+func (client *HClient) DisableTableCtx(ctx context.Context, tableName string) (err error) {
+	return checkHbaseError(client.call(ctx, "DisableTable", tableName, func() error {
+		return client.hbase.DisableTable(proto.Bytes(tableName))
+	}))
 }
 
 /**
@@ -144,7 +327,17 @@ func (client *HClient) DisableTable(tableName string) (err error) {
  *  - TableName: name of the table to check
  */
 func (client *HClient) IsTableEnabled(tableName string) (ret bool, err error) {
-	ret, e1 := client.hbase.IsTableEnabled(proto.Bytes(tableName))
+	return client.IsTableEnabledCtx(context.Background(), tableName)
+}
+
+/**
+ * IsTableEnabledCtx is like IsTableEnabled but honors the deadline and cancellation of ctx.
+ */
+func (client *HClient) IsTableEnabledCtx(ctx context.Context, tableName string) (ret bool, err error) {
+	e1 := client.call(ctx, "IsTableEnabled", tableName, func() (e error) {
+		ret, e = client.hbase.IsTableEnabled(proto.Bytes(tableName))
+		return
+	})
 	err = checkHbaseError(e1)
 	return
 }
@@ -154,7 +347,16 @@ func (client *HClient) IsTableEnabled(tableName string) (ret bool, err error) {
  *  - TableNameOrRegionName
  */
 func (client *HClient) Compact(tableNameOrRegionName string) (err error) {
-	return checkHbaseError(client.hbase.Compact(proto.Bytes(tableNameOrRegionName)))
+	return client.CompactCtx(context.Background(), tableNameOrRegionName)
+}
+
+/**
+ * CompactCtx is like Compact but honors the deadline and cancellation of ctx.
+ */
+func (client *HClient) CompactCtx(ctx context.Context, tableNameOrRegionName string) (err error) {
+	return checkHbaseError(client.call(ctx, "Compact", tableNameOrRegionName, func() error {
+		return client.hbase.Compact(proto.Bytes(tableNameOrRegionName))
+	}))
 }
 
 /**
@@ -162,7 +364,16 @@ func (client *HClient) Compact(tableNameOrRegionName string) (err error) {
  *  - TableNameOrRegionName
  */
 func (client *HClient) MajorCompact(tableNameOrRegionName string) (err error) {
-	return checkHbaseError(client.hbase.MajorCompact(proto.Bytes(tableNameOrRegionName)))
+	return client.MajorCompactCtx(context.Background(), tableNameOrRegionName)
+}
+
+/**
+ * MajorCompactCtx is like MajorCompact but honors the deadline and cancellation of ctx.
+ */
+func (client *HClient) MajorCompactCtx(ctx context.Context, tableNameOrRegionName string) (err error) {
+	return checkHbaseError(client.call(ctx, "MajorCompact", tableNameOrRegionName, func() error {
+		return client.hbase.MajorCompact(proto.Bytes(tableNameOrRegionName))
+	}))
 }
 
 /**
@@ -174,7 +385,18 @@ func (client *HClient) MajorCompact(tableNameOrRegionName string) (err error) {
  *  - TableName: table name
  */
 func (client *HClient) GetTableNames() (tables []string, err error) {
-	ret, e1 := client.hbase.GetTableNames()
+	return client.GetTableNamesCtx(context.Background())
+}
+
+/**
+ * GetTableNamesCtx is like GetTableNames but honors the deadline and cancellation of ctx.
+ */
+func (client *HClient) GetTableNamesCtx(ctx context.Context) (tables []string, err error) {
+	var ret [][]byte
+	e1 := client.call(ctx, "GetTableNames", "", func() (e error) {
+		ret, e = client.hbase.GetTableNames()
+		return
+	})
 	if err = checkHbaseError(e1); err != nil {
 		return
 	}
@@ -192,7 +414,18 @@ func (client *HClient) GetTableNames() (tables []string, err error) {
  *  - TableName: table name
  */
 func (client *HClient) GetColumnDescriptors(tableName string) (columns map[string]*ColumnDescriptor, err error) {
-	ret, e1 := client.hbase.GetColumnDescriptors(proto.Text(tableName))
+	return client.GetColumnDescriptorsCtx(context.Background(), tableName)
+}
+
+/**
+ * GetColumnDescriptorsCtx is like GetColumnDescriptors but honors the deadline and cancellation of ctx.
+ */
+func (client *HClient) GetColumnDescriptorsCtx(ctx context.Context, tableName string) (columns map[string]*ColumnDescriptor, err error) {
+	var ret map[string]*proto.ColumnDescriptor
+	e1 := client.call(ctx, "GetColumnDescriptors", tableName, func() (e error) {
+		ret, e = client.hbase.GetColumnDescriptors(proto.Text(tableName))
+		return
+	})
 	if err = checkHbaseError(e1); err != nil {
 		return
 	}
@@ -209,7 +442,18 @@ func (client *HClient) GetColumnDescriptors(tableName string) (columns map[strin
  *  - TableName: table name
  */
 func (client *HClient) GetTableRegions(tableName string) (regions []*TRegionInfo, err error) {
-	ret, e1 := client.hbase.GetTableRegions(proto.Text(tableName))
+	return client.GetTableRegionsCtx(context.Background(), tableName)
+}
+
+/**
+ * GetTableRegionsCtx is like GetTableRegions but honors the deadline and cancellation of ctx.
+ */
+func (client *HClient) GetTableRegionsCtx(ctx context.Context, tableName string) (regions []*TRegionInfo, err error) {
+	var ret []*proto.TRegionInfo
+	e1 := client.call(ctx, "GetTableRegions", tableName, func() (e error) {
+		ret, e = client.hbase.GetTableRegions(proto.Text(tableName))
+		return
+	})
 	if err = checkHbaseError(e1); err != nil {
 		return
 	}
@@ -224,18 +468,31 @@ func (client *HClient) GetTableRegions(tableName string) (regions []*TRegionInfo
  * colon (:). All other fields are optional and will get default
  * values if not explicitly specified.
  *
- * @throws IllegalArgument if an input parameter is invalid
+ * @return exists true if the table name already exists, the table is left untouched
  *
- * @throws AlreadyExists if the table name already exists
+ * @throws IllegalArgument if an input parameter is invalid
  *
  * Parameters:
  *  - TableName: name of table to create
  *  - ColumnFamilies: list of column family descriptors
  */
 func (client *HClient) CreateTable(tableName string, columnFamilies []*ColumnDescriptor) (exists bool, err error) {
+	return client.CreateTableCtx(context.Background(), tableName, columnFamilies)
+}
+
+/**
+ * CreateTableCtx is like CreateTable but honors the deadline and cancellation of ctx.
+ */
+func (client *HClient) CreateTableCtx(ctx context.Context, tableName string, columnFamilies []*ColumnDescriptor) (exists bool, err error) {
 	columns := toHbaseColList(columnFamilies)
-	e1 := client.hbase.CreateTable(proto.Text(tableName), columns)
+	e1 := client.call(ctx, "CreateTable", tableName, func() error {
+		return client.hbase.CreateTable(proto.Text(tableName), columns)
+	})
 	if err = checkHbaseError(e1); err != nil {
+		if errors.Is(err, ErrTableExists) {
+			exists = true
+			err = nil
+		}
 		return
 	}
 	return
@@ -251,7 +508,16 @@ func (client *HClient) CreateTable(tableName string, columnFamilies []*ColumnDes
  *  - TableName: name of table to delete
  */
 func (client *HClient) DeleteTable(tableName string) (err error) {
-	return checkHbaseError(client.hbase.DeleteTable(proto.Text(tableName)))
+	return client.DeleteTableCtx(context.Background(), tableName)
+}
+
+/**
+ * DeleteTableCtx is like DeleteTable but honors the deadline and cancellation of ctx.
+ */
+func (client *HClient) DeleteTableCtx(ctx context.Context, tableName string) (err error) {
+	return checkHbaseError(client.call(ctx, "DeleteTable", tableName, func() error {
+		return client.hbase.DeleteTable(proto.Text(tableName))
+	}))
 }
 
 /**
@@ -267,7 +533,18 @@ func (client *HClient) DeleteTable(tableName string) (err error) {
  *  - Attributes: Get attributes
  */
 func (client *HClient) Get(tableName string, row []byte, column string, attributes map[string]string) (data []*proto.TCell, err error) {
-	ret, e1 := client.hbase.Get(proto.Text(tableName), proto.Text(row), proto.Text(column), toHbaseTextMap(attributes))
+	return client.GetCtx(context.Background(), tableName, row, column, attributes)
+}
+
+/**
+ * GetCtx is like Get but honors the deadline and cancellation of ctx.
+ */
+func (client *HClient) GetCtx(ctx context.Context, tableName string, row []byte, column string, attributes map[string]string) (data []*proto.TCell, err error) {
+	var ret []*proto.TCell
+	e1 := client.call(ctx, "Get", tableName, func() (e error) {
+		ret, e = client.hbase.Get(proto.Text(tableName), proto.Text(row), proto.Text(column), toHbaseTextMap(attributes))
+		return
+	})
 	if err = checkHbaseError(e1); err != nil {
 		return
 	}
@@ -290,7 +567,18 @@ func (client *HClient) Get(tableName string, row []byte, column string, attribut
  *  - Attributes: Get attributes
  */
 func (client *HClient) GetVer(tableName string, row []byte, column string, numVersions int32, attributes map[string]string) (data []*proto.TCell, err error) {
-	ret, e1 := client.hbase.GetVer(proto.Text(tableName), proto.Text(row), proto.Text(column), numVersions, toHbaseTextMap(attributes))
+	return client.GetVerCtx(context.Background(), tableName, row, column, numVersions, attributes)
+}
+
+/**
+ * GetVerCtx is like GetVer but honors the deadline and cancellation of ctx.
+ */
+func (client *HClient) GetVerCtx(ctx context.Context, tableName string, row []byte, column string, numVersions int32, attributes map[string]string) (data []*proto.TCell, err error) {
+	var ret []*proto.TCell
+	e1 := client.call(ctx, "GetVer", tableName, func() (e error) {
+		ret, e = client.hbase.GetVer(proto.Text(tableName), proto.Text(row), proto.Text(column), numVersions, toHbaseTextMap(attributes))
+		return
+	})
 	if err = checkHbaseError(e1); err != nil {
 		return
 	}
@@ -315,7 +603,18 @@ func (client *HClient) GetVer(tableName string, row []byte, column string, numVe
  *  - Attributes: Get attributes
  */
 func (client *HClient) GetVerTs(tableName string, row []byte, column string, timestamp int64, numVersions int32, attributes map[string]string) (data []*proto.TCell, err error) {
-	ret, e1 := client.hbase.GetVerTs(proto.Text(tableName), proto.Text(row), proto.Text(column), timestamp, numVersions, toHbaseTextMap(attributes))
+	return client.GetVerTsCtx(context.Background(), tableName, row, column, timestamp, numVersions, attributes)
+}
+
+/**
+ * GetVerTsCtx is like GetVerTs but honors the deadline and cancellation of ctx.
+ */
+func (client *HClient) GetVerTsCtx(ctx context.Context, tableName string, row []byte, column string, timestamp int64, numVersions int32, attributes map[string]string) (data []*proto.TCell, err error) {
+	var ret []*proto.TCell
+	e1 := client.call(ctx, "GetVerTs", tableName, func() (e error) {
+		ret, e = client.hbase.GetVerTs(proto.Text(tableName), proto.Text(row), proto.Text(column), timestamp, numVersions, toHbaseTextMap(attributes))
+		return
+	})
 	if err = checkHbaseError(e1); err != nil {
 		return
 	}
@@ -336,7 +635,18 @@ func (client *HClient) GetVerTs(tableName string, row []byte, column string, tim
  *  - Attributes: Get attributes
  */
 func (client *HClient) GetRow(tableName string, row []byte, attributes map[string]string) (data []*proto.TRowResult_, err error) {
-	ret, e1 := client.hbase.GetRow(proto.Text(tableName), proto.Text(row), toHbaseTextMap(attributes))
+	return client.GetRowCtx(context.Background(), tableName, row, attributes)
+}
+
+/**
+ * GetRowCtx is like GetRow but honors the deadline and cancellation of ctx.
+ */
+func (client *HClient) GetRowCtx(ctx context.Context, tableName string, row []byte, attributes map[string]string) (data []*proto.TRowResult_, err error) {
+	var ret []*proto.TRowResult_
+	e1 := client.call(ctx, "GetRow", tableName, func() (e error) {
+		ret, e = client.hbase.GetRow(proto.Text(tableName), proto.Text(row), toHbaseTextMap(attributes))
+		return
+	})
 	if err = checkHbaseError(e1); err != nil {
 		return
 	}
@@ -358,7 +668,18 @@ func (client *HClient) GetRow(tableName string, row []byte, attributes map[strin
  *  - Attributes: Get attributes
  */
 func (client *HClient) GetRowWithColumns(tableName string, row []byte, columns []string, attributes map[string]string) (data []*proto.TRowResult_, err error) {
-	ret, e1 := client.hbase.GetRowWithColumns(proto.Text(tableName), proto.Text(row), toHbaseTextList(columns), toHbaseTextMap(attributes))
+	return client.GetRowWithColumnsCtx(context.Background(), tableName, row, columns, attributes)
+}
+
+/**
+ * GetRowWithColumnsCtx is like GetRowWithColumns but honors the deadline and cancellation of ctx.
+ */
+func (client *HClient) GetRowWithColumnsCtx(ctx context.Context, tableName string, row []byte, columns []string, attributes map[string]string) (data []*proto.TRowResult_, err error) {
+	var ret []*proto.TRowResult_
+	e1 := client.call(ctx, "GetRowWithColumns", tableName, func() (e error) {
+		ret, e = client.hbase.GetRowWithColumns(proto.Text(tableName), proto.Text(row), toHbaseTextList(columns), toHbaseTextMap(attributes))
+		return
+	})
 	if err = checkHbaseError(e1); err != nil {
 		return
 	}
@@ -380,7 +701,18 @@ func (client *HClient) GetRowWithColumns(tableName string, row []byte, columns [
  *  - Attributes: Get attributes
  */
 func (client *HClient) GetRowTs(tableName string, row []byte, timestamp int64, attributes map[string]string) (data []*proto.TRowResult_, err error) {
-	ret, e1 := client.hbase.GetRowTs(proto.Text(tableName), proto.Text(row), timestamp, toHbaseTextMap(attributes))
+	return client.GetRowTsCtx(context.Background(), tableName, row, timestamp, attributes)
+}
+
+/**
+ * GetRowTsCtx is like GetRowTs but honors the deadline and cancellation of ctx.
+ */
+func (client *HClient) GetRowTsCtx(ctx context.Context, tableName string, row []byte, timestamp int64, attributes map[string]string) (data []*proto.TRowResult_, err error) {
+	var ret []*proto.TRowResult_
+	e1 := client.call(ctx, "GetRowTs", tableName, func() (e error) {
+		ret, e = client.hbase.GetRowTs(proto.Text(tableName), proto.Text(row), timestamp, toHbaseTextMap(attributes))
+		return
+	})
 	if err = checkHbaseError(e1); err != nil {
 		return
 	}
@@ -403,7 +735,18 @@ func (client *HClient) GetRowTs(tableName string, row []byte, timestamp int64, a
  *  - Attributes: Get attributes
  */
 func (client *HClient) GetRowWithColumnsTs(tableName string, row []byte, columns []string, timestamp int64, attributes map[string]string) (data []*proto.TRowResult_, err error) {
-	ret, e1 := client.hbase.GetRowWithColumnsTs(proto.Text(tableName), proto.Text(row), toHbaseTextList(columns), timestamp, toHbaseTextMap(attributes))
+	return client.GetRowWithColumnsTsCtx(context.Background(), tableName, row, columns, timestamp, attributes)
+}
+
+/**
+ * GetRowWithColumnsTsCtx is like GetRowWithColumnsTs but honors the deadline and cancellation of ctx.
+ */
+func (client *HClient) GetRowWithColumnsTsCtx(ctx context.Context, tableName string, row []byte, columns []string, timestamp int64, attributes map[string]string) (data []*proto.TRowResult_, err error) {
+	var ret []*proto.TRowResult_
+	e1 := client.call(ctx, "GetRowWithColumnsTs", tableName, func() (e error) {
+		ret, e = client.hbase.GetRowWithColumnsTs(proto.Text(tableName), proto.Text(row), toHbaseTextList(columns), timestamp, toHbaseTextMap(attributes))
+		return
+	})
 	if err = checkHbaseError(e1); err != nil {
 		return
 	}
@@ -424,7 +767,18 @@ func (client *HClient) GetRowWithColumnsTs(tableName string, row []byte, columns
  *  - Attributes: Get attributes
  */
 func (client *HClient) GetRows(tableName string, rows [][]byte, attributes map[string]string) (data []*proto.TRowResult_, err error) {
-	ret, e1 := client.hbase.GetRows(proto.Text(tableName), rows, toHbaseTextMap(attributes))
+	return client.GetRowsCtx(context.Background(), tableName, rows, attributes)
+}
+
+/**
+ * GetRowsCtx is like GetRows but honors the deadline and cancellation of ctx.
+ */
+func (client *HClient) GetRowsCtx(ctx context.Context, tableName string, rows [][]byte, attributes map[string]string) (data []*proto.TRowResult_, err error) {
+	var ret []*proto.TRowResult_
+	e1 := client.call(ctx, "GetRows", tableName, func() (e error) {
+		ret, e = client.hbase.GetRows(proto.Text(tableName), rows, toHbaseTextMap(attributes))
+		return
+	})
 	if err = checkHbaseError(e1); err != nil {
 		return
 	}
@@ -446,11 +800,22 @@ func (client *HClient) GetRows(tableName string, rows [][]byte, attributes map[s
  *  - Attributes: Get attributes
  */
 func (client *HClient) GetRowsWithColumns(tableName string, rows [][]byte, columns []string, attributes map[string]string) (data []*proto.TRowResult_, err error) {
+	return client.GetRowsWithColumnsCtx(context.Background(), tableName, rows, columns, attributes)
+}
+
+/**
+ * GetRowsWithColumnsCtx is like GetRowsWithColumns but honors the deadline and cancellation of ctx.
+ */
+func (client *HClient) GetRowsWithColumnsCtx(ctx context.Context, tableName string, rows [][]byte, columns []string, attributes map[string]string) (data []*proto.TRowResult_, err error) {
 	if err = client.Open(); err != nil {
 		return
 	}
 
-	ret, e1 := client.hbase.GetRowsWithColumns(proto.Text(tableName), rows, toHbaseTextList(columns), toHbaseTextMap(attributes))
+	var ret []*proto.TRowResult_
+	e1 := client.call(ctx, "GetRowsWithColumns", tableName, func() (e error) {
+		ret, e = client.hbase.GetRowsWithColumns(proto.Text(tableName), rows, toHbaseTextList(columns), toHbaseTextMap(attributes))
+		return
+	})
 	if err = checkHbaseError(e1); err != nil {
 		return
 	}
@@ -472,7 +837,18 @@ func (client *HClient) GetRowsWithColumns(tableName string, rows [][]byte, colum
  *  - Attributes: Get attributes
  */
 func (client *HClient) GetRowsTs(tableName string, rows [][]byte, timestamp int64, attributes map[string]string) (data []*proto.TRowResult_, err error) {
-	ret, e1 := client.hbase.GetRowsTs(proto.Text(tableName), rows, timestamp, toHbaseTextMap(attributes))
+	return client.GetRowsTsCtx(context.Background(), tableName, rows, timestamp, attributes)
+}
+
+/**
+ * GetRowsTsCtx is like GetRowsTs but honors the deadline and cancellation of ctx.
+ */
+func (client *HClient) GetRowsTsCtx(ctx context.Context, tableName string, rows [][]byte, timestamp int64, attributes map[string]string) (data []*proto.TRowResult_, err error) {
+	var ret []*proto.TRowResult_
+	e1 := client.call(ctx, "GetRowsTs", tableName, func() (e error) {
+		ret, e = client.hbase.GetRowsTs(proto.Text(tableName), rows, timestamp, toHbaseTextMap(attributes))
+		return
+	})
 	if err = checkHbaseError(e1); err != nil {
 		return
 	}
@@ -495,7 +871,18 @@ func (client *HClient) GetRowsTs(tableName string, rows [][]byte, timestamp int6
  *  - Attributes: Get attributes
  */
 func (client *HClient) GetRowsWithColumnsTs(tableName string, rows [][]byte, columns []string, timestamp int64, attributes map[string]string) (data []*proto.TRowResult_, err error) {
-	ret, e1 := client.hbase.GetRowsWithColumnsTs(proto.Text(tableName), rows, toHbaseTextList(columns), timestamp, toHbaseTextMap(attributes))
+	return client.GetRowsWithColumnsTsCtx(context.Background(), tableName, rows, columns, timestamp, attributes)
+}
+
+/**
+ * GetRowsWithColumnsTsCtx is like GetRowsWithColumnsTs but honors the deadline and cancellation of ctx.
+ */
+func (client *HClient) GetRowsWithColumnsTsCtx(ctx context.Context, tableName string, rows [][]byte, columns []string, timestamp int64, attributes map[string]string) (data []*proto.TRowResult_, err error) {
+	var ret []*proto.TRowResult_
+	e1 := client.call(ctx, "GetRowsWithColumnsTs", tableName, func() (e error) {
+		ret, e = client.hbase.GetRowsWithColumnsTs(proto.Text(tableName), rows, toHbaseTextList(columns), timestamp, toHbaseTextMap(attributes))
+		return
+	})
 	if err = checkHbaseError(e1); err != nil {
 		return
 	}
@@ -517,7 +904,16 @@ func (client *HClient) GetRowsWithColumnsTs(tableName string, rows [][]byte, col
  *  - Attributes: Mutation attributes
  */
 func (client *HClient) MutateRow(tableName string, row []byte, mutations []*proto.Mutation, attributes map[string]string) error {
-	return checkHbaseError(client.hbase.MutateRow(proto.Text(tableName), proto.Text(row), mutations, toHbaseTextMap(attributes)))
+	return client.MutateRowCtx(context.Background(), tableName, row, mutations, attributes)
+}
+
+/**
+ * MutateRowCtx is like MutateRow but honors the deadline and cancellation of ctx.
+ */
+func (client *HClient) MutateRowCtx(ctx context.Context, tableName string, row []byte, mutations []*proto.Mutation, attributes map[string]string) error {
+	return checkHbaseError(client.call(ctx, "MutateRow", tableName, func() error {
+		return client.hbase.MutateRow(proto.Text(tableName), proto.Text(row), mutations, toHbaseTextMap(attributes))
+	}))
 }
 
 /**
@@ -534,7 +930,16 @@ func (client *HClient) MutateRow(tableName string, row []byte, mutations []*prot
  *  - Attributes: Mutation attributes
  */
 func (client *HClient) MutateRowTs(tableName string, row []byte, mutations []*proto.Mutation, timestamp int64, attributes map[string]string) error {
-	return checkHbaseError(client.hbase.MutateRowTs(proto.Text(tableName), proto.Text(row), mutations, timestamp, toHbaseTextMap(attributes)))
+	return client.MutateRowTsCtx(context.Background(), tableName, row, mutations, timestamp, attributes)
+}
+
+/**
+ * MutateRowTsCtx is like MutateRowTs but honors the deadline and cancellation of ctx.
+ */
+func (client *HClient) MutateRowTsCtx(ctx context.Context, tableName string, row []byte, mutations []*proto.Mutation, timestamp int64, attributes map[string]string) error {
+	return checkHbaseError(client.call(ctx, "MutateRowTs", tableName, func() error {
+		return client.hbase.MutateRowTs(proto.Text(tableName), proto.Text(row), mutations, timestamp, toHbaseTextMap(attributes))
+	}))
 }
 
 /**
@@ -549,7 +954,16 @@ func (client *HClient) MutateRowTs(tableName string, row []byte, mutations []*pr
  *  - Attributes: Mutation attributes
  */
 func (client *HClient) MutateRows(tableName string, rowBatches []*proto.BatchMutation, attributes map[string]string) error {
-	return checkHbaseError(client.hbase.MutateRows(proto.Text(tableName), rowBatches, toHbaseTextMap(attributes)))
+	return client.MutateRowsCtx(context.Background(), tableName, rowBatches, attributes)
+}
+
+/**
+ * MutateRowsCtx is like MutateRows but honors the deadline and cancellation of ctx.
+ */
+func (client *HClient) MutateRowsCtx(ctx context.Context, tableName string, rowBatches []*proto.BatchMutation, attributes map[string]string) error {
+	return checkHbaseError(client.call(ctx, "MutateRows", tableName, func() error {
+		return client.hbase.MutateRows(proto.Text(tableName), rowBatches, toHbaseTextMap(attributes))
+	}))
 }
 
 /**
@@ -565,7 +979,16 @@ func (client *HClient) MutateRows(tableName string, rowBatches []*proto.BatchMut
  *  - Attributes: Mutation attributes
  */
 func (client *HClient) MutateRowsTs(tableName string, rowBatches []*proto.BatchMutation, timestamp int64, attributes map[string]string) error {
-	return checkHbaseError(client.hbase.MutateRowsTs(proto.Text(tableName), rowBatches, timestamp, toHbaseTextMap(attributes)))
+	return client.MutateRowsTsCtx(context.Background(), tableName, rowBatches, timestamp, attributes)
+}
+
+/**
+ * MutateRowsTsCtx is like MutateRowsTs but honors the deadline and cancellation of ctx.
+ */
+func (client *HClient) MutateRowsTsCtx(ctx context.Context, tableName string, rowBatches []*proto.BatchMutation, timestamp int64, attributes map[string]string) error {
+	return checkHbaseError(client.call(ctx, "MutateRowsTs", tableName, func() error {
+		return client.hbase.MutateRowsTs(proto.Text(tableName), rowBatches, timestamp, toHbaseTextMap(attributes))
+	}))
 }
 
 /**
@@ -578,7 +1001,18 @@ func (client *HClient) MutateRowsTs(tableName string, rowBatches []*proto.BatchM
  *  - Value: amount to increment by
  */
 func (client *HClient) AtomicIncrement(tableName string, row []byte, column string, value int64) (v int64, err error) {
-	ret, e1 := client.hbase.AtomicIncrement(proto.Text(tableName), proto.Text(row), proto.Text(column), value)
+	return client.AtomicIncrementCtx(context.Background(), tableName, row, column, value)
+}
+
+/**
+ * AtomicIncrementCtx is like AtomicIncrement but honors the deadline and cancellation of ctx.
+ */
+func (client *HClient) AtomicIncrementCtx(ctx context.Context, tableName string, row []byte, column string, value int64) (v int64, err error) {
+	var ret int64
+	e1 := client.call(ctx, "AtomicIncrement", tableName, func() (e error) {
+		ret, e = client.hbase.AtomicIncrement(proto.Text(tableName), proto.Text(row), proto.Text(column), value)
+		return
+	})
 	if err = checkHbaseError(e1); err != nil {
 		return
 	}
@@ -597,7 +1031,16 @@ func (client *HClient) AtomicIncrement(tableName string, row []byte, column stri
  *  - Attributes: Delete attributes
  */
 func (client *HClient) DeleteAll(tableName string, row []byte, column string, attributes map[string]string) error {
-	return checkHbaseError(client.hbase.DeleteAll(proto.Text(tableName), proto.Text(row), proto.Text(column), toHbaseTextMap(attributes)))
+	return client.DeleteAllCtx(context.Background(), tableName, row, column, attributes)
+}
+
+/**
+ * DeleteAllCtx is like DeleteAll but honors the deadline and cancellation of ctx.
+ */
+func (client *HClient) DeleteAllCtx(ctx context.Context, tableName string, row []byte, column string, attributes map[string]string) error {
+	return checkHbaseError(client.call(ctx, "DeleteAll", tableName, func() error {
+		return client.hbase.DeleteAll(proto.Text(tableName), proto.Text(row), proto.Text(column), toHbaseTextMap(attributes))
+	}))
 }
 
 /**
@@ -612,7 +1055,16 @@ func (client *HClient) DeleteAll(tableName string, row []byte, column string, at
  *  - Attributes: Delete attributes
  */
 func (client *HClient) DeleteAllTs(tableName string, row []byte, column string, timestamp int64, attributes map[string]string) error {
-	return checkHbaseError(client.hbase.DeleteAllTs(proto.Text(tableName), proto.Text(row), proto.Text(column), timestamp, toHbaseTextMap(attributes)))
+	return client.DeleteAllTsCtx(context.Background(), tableName, row, column, timestamp, attributes)
+}
+
+/**
+ * DeleteAllTsCtx is like DeleteAllTs but honors the deadline and cancellation of ctx.
+ */
+func (client *HClient) DeleteAllTsCtx(ctx context.Context, tableName string, row []byte, column string, timestamp int64, attributes map[string]string) error {
+	return checkHbaseError(client.call(ctx, "DeleteAllTs", tableName, func() error {
+		return client.hbase.DeleteAllTs(proto.Text(tableName), proto.Text(row), proto.Text(column), timestamp, toHbaseTextMap(attributes))
+	}))
 }
 
 /**
@@ -624,7 +1076,16 @@ func (client *HClient) DeleteAllTs(tableName string, row []byte, column string,
  *  - Attributes: Delete attributes
  */
 func (client *HClient) DeleteAllRow(tableName string, row []byte, attributes map[string]string) error {
-	return checkHbaseError(client.hbase.DeleteAllRow(proto.Text(tableName), proto.Text(row), toHbaseTextMap(attributes)))
+	return client.DeleteAllRowCtx(context.Background(), tableName, row, attributes)
+}
+
+/**
+ * DeleteAllRowCtx is like DeleteAllRow but honors the deadline and cancellation of ctx.
+ */
+func (client *HClient) DeleteAllRowCtx(ctx context.Context, tableName string, row []byte, attributes map[string]string) error {
+	return checkHbaseError(client.call(ctx, "DeleteAllRow", tableName, func() error {
+		return client.hbase.DeleteAllRow(proto.Text(tableName), proto.Text(row), toHbaseTextMap(attributes))
+	}))
 }
 
 /**
@@ -637,7 +1098,16 @@ func (client *HClient) DeleteAllRow(tableName string, row []byte, attributes map
  *  - Increment: The single increment to apply
  */
 func (client *HClient) Increment(increment *proto.TIncrement) error {
-	return checkHbaseError(client.hbase.Increment(increment))
+	return client.IncrementCtx(context.Background(), increment)
+}
+
+/**
+ * IncrementCtx is like Increment but honors the deadline and cancellation of ctx.
+ */
+func (client *HClient) IncrementCtx(ctx context.Context, increment *proto.TIncrement) error {
+	return checkHbaseError(client.call(ctx, "Increment", string(increment.Table), func() error {
+		return client.hbase.Increment(increment)
+	}))
 }
 
 /**
@@ -645,7 +1115,59 @@ func (client *HClient) Increment(increment *proto.TIncrement) error {
  *  - Increments: The list of increments
  */
 func (client *HClient) IncrementRows(increments []*proto.TIncrement) error {
-	return checkHbaseError(client.hbase.IncrementRows(increments))
+	return client.IncrementRowsCtx(context.Background(), increments)
+}
+
+/**
+ * IncrementRowsCtx is like IncrementRows but honors the deadline and cancellation of ctx.
+ */
+func (client *HClient) IncrementRowsCtx(ctx context.Context, increments []*proto.TIncrement) error {
+	return checkHbaseError(client.call(ctx, "IncrementRows", "", func() error {
+		return client.hbase.IncrementRows(increments)
+	}))
+}
+
+/**
+ * Appends values to one or more columns within a single row.
+ *
+ * @return values of columns after the append operation.
+ *
+ * Parameters:
+ *  - TableName: name of table
+ *  - Row: row key
+ *  - Columns: columns to append to, each value is appended to the column at the same index
+ *  - Values: values to append
+ */
+func (client *HClient) Append(tableName string, row []byte, columns []string, values [][]byte) (data []*proto.TCell, err error) {
+	return client.AppendCtx(context.Background(), tableName, row, columns, values)
+}
+
+/**
+ * AppendCtx is like Append but honors the deadline and cancellation of ctx.
+ */
+func (client *HClient) AppendCtx(ctx context.Context, tableName string, row []byte, columns []string, values [][]byte) (data []*proto.TCell, err error) {
+	if len(columns) == 0 {
+		err = newHbaseError(&proto.IllegalArgument{Message: "Append: no columns"}, nil)
+		return
+	}
+
+	if len(columns) != len(values) {
+		err = newHbaseError(&proto.IllegalArgument{
+			Message: fmt.Sprintf("Append: %d columns but %d values", len(columns), len(values))}, nil)
+		return
+	}
+
+	var ret []*proto.TCell
+	e1 := client.call(ctx, "Append", tableName, func() (e error) {
+		ret, e = client.hbase.Append(NewTAppend(tableName, row, columns, values))
+		return
+	})
+	if err = checkHbaseError(e1); err != nil {
+		return
+	}
+
+	data = ret
+	return
 }
 
 /**
@@ -659,7 +1181,16 @@ func (client *HClient) IncrementRows(increments []*proto.TIncrement) error {
  *  - Attributes: Delete attributes
  */
 func (client *HClient) DeleteAllRowTs(tableName string, row []byte, timestamp int64, attributes map[string]string) error {
-	return checkHbaseError(client.hbase.DeleteAllRowTs(proto.Text(tableName), proto.Text(row), timestamp, toHbaseTextMap(attributes)))
+	return client.DeleteAllRowTsCtx(context.Background(), tableName, row, timestamp, attributes)
+}
+
+/**
+ * DeleteAllRowTsCtx is like DeleteAllRowTs but honors the deadline and cancellation of ctx.
+ */
+func (client *HClient) DeleteAllRowTsCtx(ctx context.Context, tableName string, row []byte, timestamp int64, attributes map[string]string) error {
+	return checkHbaseError(client.call(ctx, "DeleteAllRowTs", tableName, func() error {
+		return client.hbase.DeleteAllRowTs(proto.Text(tableName), proto.Text(row), timestamp, toHbaseTextMap(attributes))
+	}))
 }
 
 /**
@@ -672,7 +1203,18 @@ func (client *HClient) DeleteAllRowTs(tableName string, row []byte, timestamp in
  *  - Attributes: Scan attributes
  */
 func (client *HClient) ScannerOpenWithScan(tableName string, scan *TScan, attributes map[string]string) (id int32, err error) {
-	ret, e1 := client.hbase.ScannerOpenWithScan(proto.Text(tableName), toHbaseTScan(scan), toHbaseTextMap(attributes))
+	return client.ScannerOpenWithScanCtx(context.Background(), tableName, scan, attributes)
+}
+
+/**
+ * ScannerOpenWithScanCtx is like ScannerOpenWithScan but honors the deadline and cancellation of ctx.
+ */
+func (client *HClient) ScannerOpenWithScanCtx(ctx context.Context, tableName string, scan *TScan, attributes map[string]string) (id int32, err error) {
+	var ret proto.ScannerID
+	e1 := client.call(ctx, "ScannerOpenWithScan", tableName, func() (e error) {
+		ret, e = client.hbase.ScannerOpenWithScan(proto.Text(tableName), toHbaseTScan(scan), toHbaseTextMap(attributes))
+		return
+	})
 	if err = checkHbaseError(e1); err != nil {
 		return
 	}
@@ -697,7 +1239,18 @@ func (client *HClient) ScannerOpenWithScan(tableName string, scan *TScan, attrib
  *  - Attributes: Scan attributes
  */
 func (client *HClient) ScannerOpen(tableName string, startRow []byte, columns []string, attributes map[string]string) (id int32, err error) {
-	ret, e1 := client.hbase.ScannerOpen(proto.Text(tableName), proto.Text(startRow), toHbaseTextList(columns), toHbaseTextMap(attributes))
+	return client.ScannerOpenCtx(context.Background(), tableName, startRow, columns, attributes)
+}
+
+/**
+ * ScannerOpenCtx is like ScannerOpen but honors the deadline and cancellation of ctx.
+ */
+func (client *HClient) ScannerOpenCtx(ctx context.Context, tableName string, startRow []byte, columns []string, attributes map[string]string) (id int32, err error) {
+	var ret proto.ScannerID
+	e1 := client.call(ctx, "ScannerOpen", tableName, func() (e error) {
+		ret, e = client.hbase.ScannerOpen(proto.Text(tableName), proto.Text(startRow), toHbaseTextList(columns), toHbaseTextMap(attributes))
+		return
+	})
 	if err = checkHbaseError(e1); err != nil {
 		return
 	}
@@ -725,7 +1278,18 @@ func (client *HClient) ScannerOpen(tableName string, startRow []byte, columns []
  *  - Attributes: Scan attributes
  */
 func (client *HClient) ScannerOpenWithStop(tableName string, startRow []byte, stopRow []byte, columns []string, attributes map[string]string) (id int32, err error) {
-	ret, e1 := client.hbase.ScannerOpenWithStop(proto.Text(tableName), proto.Text(startRow), proto.Text(stopRow), toHbaseTextList(columns), toHbaseTextMap(attributes))
+	return client.ScannerOpenWithStopCtx(context.Background(), tableName, startRow, stopRow, columns, attributes)
+}
+
+/**
+ * ScannerOpenWithStopCtx is like ScannerOpenWithStop but honors the deadline and cancellation of ctx.
+ */
+func (client *HClient) ScannerOpenWithStopCtx(ctx context.Context, tableName string, startRow []byte, stopRow []byte, columns []string, attributes map[string]string) (id int32, err error) {
+	var ret proto.ScannerID
+	e1 := client.call(ctx, "ScannerOpenWithStop", tableName, func() (e error) {
+		ret, e = client.hbase.ScannerOpenWithStop(proto.Text(tableName), proto.Text(startRow), proto.Text(stopRow), toHbaseTextList(columns), toHbaseTextMap(attributes))
+		return
+	})
 	if err = checkHbaseError(e1); err != nil {
 		return
 	}
@@ -747,7 +1311,18 @@ func (client *HClient) ScannerOpenWithStop(tableName string, startRow []byte, st
  *  - Attributes: Scan attributes
  */
 func (client *HClient) ScannerOpenWithPrefix(tableName string, startAndPrefix []byte, columns []string, attributes map[string]string) (id int32, err error) {
-	ret, e1 := client.hbase.ScannerOpenWithPrefix(proto.Text(tableName), proto.Text(startAndPrefix), toHbaseTextList(columns), toHbaseTextMap(attributes))
+	return client.ScannerOpenWithPrefixCtx(context.Background(), tableName, startAndPrefix, columns, attributes)
+}
+
+/**
+ * ScannerOpenWithPrefixCtx is like ScannerOpenWithPrefix but honors the deadline and cancellation of ctx.
+ */
+func (client *HClient) ScannerOpenWithPrefixCtx(ctx context.Context, tableName string, startAndPrefix []byte, columns []string, attributes map[string]string) (id int32, err error) {
+	var ret proto.ScannerID
+	e1 := client.call(ctx, "ScannerOpenWithPrefix", tableName, func() (e error) {
+		ret, e = client.hbase.ScannerOpenWithPrefix(proto.Text(tableName), proto.Text(startAndPrefix), toHbaseTextList(columns), toHbaseTextMap(attributes))
+		return
+	})
 	if err = checkHbaseError(e1); err != nil {
 		return
 	}
@@ -774,7 +1349,18 @@ func (client *HClient) ScannerOpenWithPrefix(tableName string, startAndPrefix []
  *  - Attributes: Scan attributes
  */
 func (client *HClient) ScannerOpenTs(tableName string, startRow []byte, columns []string, timestamp int64, attributes map[string]string) (id int32, err error) {
-	ret, e1 := client.hbase.ScannerOpenTs(proto.Text(tableName), proto.Text(startRow), toHbaseTextList(columns), timestamp, toHbaseTextMap(attributes))
+	return client.ScannerOpenTsCtx(context.Background(), tableName, startRow, columns, timestamp, attributes)
+}
+
+/**
+ * ScannerOpenTsCtx is like ScannerOpenTs but honors the deadline and cancellation of ctx.
+ */
+func (client *HClient) ScannerOpenTsCtx(ctx context.Context, tableName string, startRow []byte, columns []string, timestamp int64, attributes map[string]string) (id int32, err error) {
+	var ret proto.ScannerID
+	e1 := client.call(ctx, "ScannerOpenTs", tableName, func() (e error) {
+		ret, e = client.hbase.ScannerOpenTs(proto.Text(tableName), proto.Text(startRow), toHbaseTextList(columns), timestamp, toHbaseTextMap(attributes))
+		return
+	})
 	if err = checkHbaseError(e1); err != nil {
 		return
 	}
@@ -804,7 +1390,18 @@ func (client *HClient) ScannerOpenTs(tableName string, startRow []byte, columns
  *  - Attributes: Scan attributes
  */
 func (client *HClient) ScannerOpenWithStopTs(tableName string, startRow []byte, stopRow []byte, columns []string, timestamp int64, attributes map[string]string) (id int32, err error) {
-	ret, e1 := client.hbase.ScannerOpenWithStopTs(proto.Text(tableName), proto.Text(startRow), proto.Text(stopRow), toHbaseTextList(columns), timestamp, toHbaseTextMap(attributes))
+	return client.ScannerOpenWithStopTsCtx(context.Background(), tableName, startRow, stopRow, columns, timestamp, attributes)
+}
+
+/**
+ * ScannerOpenWithStopTsCtx is like ScannerOpenWithStopTs but honors the deadline and cancellation of ctx.
+ */
+func (client *HClient) ScannerOpenWithStopTsCtx(ctx context.Context, tableName string, startRow []byte, stopRow []byte, columns []string, timestamp int64, attributes map[string]string) (id int32, err error) {
+	var ret proto.ScannerID
+	e1 := client.call(ctx, "ScannerOpenWithStopTs", tableName, func() (e error) {
+		ret, e = client.hbase.ScannerOpenWithStopTs(proto.Text(tableName), proto.Text(startRow), proto.Text(stopRow), toHbaseTextList(columns), timestamp, toHbaseTextMap(attributes))
+		return
+	})
 	if err = checkHbaseError(e1); err != nil {
 		return
 	}
@@ -829,7 +1426,18 @@ func (client *HClient) ScannerOpenWithStopTs(tableName string, startRow []byte,
  *  - Id: id of a scanner returned by scannerOpen
  */
 func (client *HClient) ScannerGet(id int32) (data []*proto.TRowResult_, err error) {
-	ret, e1 := client.hbase.ScannerGet(proto.ScannerID(id))
+	return client.ScannerGetCtx(context.Background(), id)
+}
+
+/**
+ * ScannerGetCtx is like ScannerGet but honors the deadline and cancellation of ctx.
+ */
+func (client *HClient) ScannerGetCtx(ctx context.Context, id int32) (data []*proto.TRowResult_, err error) {
+	var ret []*proto.TRowResult_
+	e1 := client.call(ctx, "ScannerGet", "", func() (e error) {
+		ret, e = client.hbase.ScannerGet(proto.ScannerID(id))
+		return
+	})
 	if err = checkHbaseError(e1); err != nil {
 		return
 	}
@@ -855,7 +1463,18 @@ func (client *HClient) ScannerGet(id int32) (data []*proto.TRowResult_, err erro
  *  - NbRows: number of results to return
  */
 func (client *HClient) ScannerGetList(id int32, nbRows int32) (data []*proto.TRowResult_, err error) {
-	ret, e1 := client.hbase.ScannerGetList(proto.ScannerID(id), nbRows)
+	return client.ScannerGetListCtx(context.Background(), id, nbRows)
+}
+
+/**
+ * ScannerGetListCtx is like ScannerGetList but honors the deadline and cancellation of ctx.
+ */
+func (client *HClient) ScannerGetListCtx(ctx context.Context, id int32, nbRows int32) (data []*proto.TRowResult_, err error) {
+	var ret []*proto.TRowResult_
+	e1 := client.call(ctx, "ScannerGetList", "", func() (e error) {
+		ret, e = client.hbase.ScannerGetList(proto.ScannerID(id), nbRows)
+		return
+	})
 	if err = checkHbaseError(e1); err != nil {
 		return
 	}
@@ -873,7 +1492,80 @@ func (client *HClient) ScannerGetList(id int32, nbRows int32) (data []*proto.TRo
  *  - Id: id of a scanner returned by scannerOpen
  */
 func (client *HClient) ScannerClose(id int32) error {
-	return checkHbaseError(client.hbase.ScannerClose(proto.ScannerID(id)))
+	return client.ScannerCloseCtx(context.Background(), id)
+}
+
+/**
+ * ScannerCloseCtx is like ScannerClose but honors the deadline and cancellation of ctx.
+ */
+func (client *HClient) ScannerCloseCtx(ctx context.Context, id int32) error {
+	return checkHbaseError(client.call(ctx, "ScannerClose", "", func() error {
+		return client.hbase.ScannerClose(proto.ScannerID(id))
+	}))
+}
+
+/**
+ * Atomically checks if a row/family/qualifier value matches the expected
+ * value. If it does, it adds the corresponding mutation operation for put.
+ *
+ * @return true if the new put was executed, false otherwise
+ *
+ * Parameters:
+ *  - TableName: name of table
+ *  - Row: row key
+ *  - Column: column name
+ *  - Value: the expected value for the column parameter, nil (or empty)
+ * means the check is for the non-existence of the column in question
+ *  - Mput: mutation for the put
+ *  - Attributes: Mutation attributes
+ */
+func (client *HClient) CheckAndPut(tableName string, row []byte, column string, value []byte, mput *proto.Mutation, attributes map[string]string) (applied bool, err error) {
+	return client.CheckAndPutCtx(context.Background(), tableName, row, column, value, mput, attributes)
+}
+
+/**
+ * CheckAndPutCtx is like CheckAndPut but honors the deadline and cancellation of ctx.
+ */
+func (client *HClient) CheckAndPutCtx(ctx context.Context, tableName string, row []byte, column string, value []byte, mput *proto.Mutation, attributes map[string]string) (applied bool, err error) {
+	if mput == nil {
+		err = newHbaseError(&proto.IllegalArgument{Message: "CheckAndPut: mput is nil"}, nil)
+		return
+	}
+
+	var ret bool
+	e1 := client.call(ctx, "CheckAndPut", tableName, func() (e error) {
+		ret, e = client.hbase.CheckAndPut(proto.Text(tableName), proto.Text(row), proto.Text(column), proto.Text(value), mput, toHbaseTextMap(attributes))
+		return
+	})
+	if err = checkHbaseError(e1); err != nil {
+		return
+	}
+
+	applied = ret
+	return
+}
+
+/**
+ * Applies the put only if the column does not exist yet ("put if absent").
+ *
+ * @return true if the new put was executed, false if the column already exists
+ *
+ * Parameters:
+ *  - TableName: name of table
+ *  - Row: row key
+ *  - Column: column name which must not exist
+ *  - Mput: mutation for the put
+ *  - Attributes: Mutation attributes
+ */
+func (client *HClient) CheckAndPutIfAbsent(tableName string, row []byte, column string, mput *proto.Mutation, attributes map[string]string) (applied bool, err error) {
+	return client.CheckAndPutIfAbsentCtx(context.Background(), tableName, row, column, mput, attributes)
+}
+
+/**
+ * CheckAndPutIfAbsentCtx is like CheckAndPutIfAbsent but honors the deadline and cancellation of ctx.
+ */
+func (client *HClient) CheckAndPutIfAbsentCtx(ctx context.Context, tableName string, row []byte, column string, mput *proto.Mutation, attributes map[string]string) (applied bool, err error) {
+	return client.CheckAndPutCtx(ctx, tableName, row, column, nil, mput, attributes)
 }
 
 /**
@@ -887,7 +1579,18 @@ func (client *HClient) ScannerClose(id int32) error {
  *  - Family: column name
  */
 func (client *HClient) GetRowOrBefore(tableName string, row string, family string) (data []*proto.TCell, err error) {
-	ret, e1 := client.hbase.GetRowOrBefore(proto.Text(tableName), proto.Text(row), proto.Text(family))
+	return client.GetRowOrBeforeCtx(context.Background(), tableName, row, family)
+}
+
+/**
+ * GetRowOrBeforeCtx is like GetRowOrBefore but honors the deadline and cancellation of ctx.
+ */
+func (client *HClient) GetRowOrBeforeCtx(ctx context.Context, tableName string, row string, family string) (data []*proto.TCell, err error) {
+	var ret []*proto.TCell
+	e1 := client.call(ctx, "GetRowOrBefore", tableName, func() (e error) {
+		ret, e = client.hbase.GetRowOrBefore(proto.Text(tableName), proto.Text(row), proto.Text(family))
+		return
+	})
 	if err = checkHbaseError(e1); err != nil {
 		return
 	}
@@ -906,7 +1609,18 @@ func (client *HClient) GetRowOrBefore(tableName string, row string, family strin
  *  - Row: row key
  */
 func (client *HClient) GetRegionInfo(row string) (region *TRegionInfo, err error) {
-	ret, e1 := client.hbase.GetRegionInfo(proto.Text(row))
+	return client.GetRegionInfoCtx(context.Background(), row)
+}
+
+/**
+ * GetRegionInfoCtx is like GetRegionInfo but honors the deadline and cancellation of ctx.
+ */
+func (client *HClient) GetRegionInfoCtx(ctx context.Context, row string) (region *TRegionInfo, err error) {
+	var ret *proto.TRegionInfo
+	e1 := client.call(ctx, "GetRegionInfo", "", func() (e error) {
+		ret, e = client.hbase.GetRegionInfo(proto.Text(row))
+		return
+	})
 	if err = checkHbaseError(e1); err != nil {
 		return
 	}
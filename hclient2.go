@@ -0,0 +1,682 @@
+package gogohbase
+
+import (
+	"context"
+
+	"git.apache.org/thrift.git/lib/go/thrift"
+	"github.com/blackbeans/gogobase/proto2"
+)
+
+/*
+HClient2 is wrap of the thrift2 THBaseService client, served by "hbase thrift2"
+*/
+type HClient2 struct {
+	thriftConn
+	hbase *proto2.THBaseServiceClient
+}
+
+/*
+NewHttpClient2 return a thrift2 http client instance
+*/
+func NewHttpClient2(rawurl string, protocol int) (client *HClient2, err error) {
+	addr, trans, err := newHttpTransport(rawurl)
+	if err != nil {
+		return
+	}
+
+	client, err = newClient2(addr, protocol, trans)
+	if err != nil {
+		return
+	}
+	client.http = trans
+	return
+}
+
+/*
+NewTcpClient2 return a thrift2 tcp client instance
+*/
+func NewTcpClient2(rawaddr string, protocol int, framed bool) (client *HClient2, err error) {
+	socket, trans, err := newTcpTransport(rawaddr, framed)
+	if err != nil {
+		return
+	}
+
+	client, err = newClient2(rawaddr, protocol, trans)
+	if err != nil {
+		return
+	}
+	client.socket = socket
+	return
+}
+
+/*
+newClient2 create a new thrift2 client
+*/
+func newClient2(addr string, protocol int, trans thrift.TTransport) (*HClient2, error) {
+	protocolFactory, err := newProtocolFactory(protocol)
+	if err != nil {
+		return nil, err
+	}
+
+	return &HClient2{
+		thriftConn: thriftConn{
+			addr:            addr,
+			Protocol:        protocol,
+			ProtocolFactory: protocolFactory,
+			Trans:           trans,
+		},
+		hbase: proto2.NewTHBaseServiceClientFactory(trans, protocolFactory),
+	}, nil
+}
+
+/**
+ * Test for the existence of columns in the table, as specified in the TGet.
+ *
+ * @return true if the specified TGet matches one or more keys, false if not
+ *
+ * Parameters:
+ *  - Table: the table to check on
+ *  - Tget: the TGet to check for
+ */
+func (client *HClient2) Exists(table string, tget *proto2.TGet) (bool, error) {
+	return client.ExistsCtx(context.Background(), table, tget)
+}
+
+/**
+ * ExistsCtx is like Exists but honors the deadline and cancellation of ctx.
+ */
+func (client *HClient2) ExistsCtx(ctx context.Context, table string, tget *proto2.TGet) (exists bool, err error) {
+	err = checkHbaseError(client.call(ctx, "Exists", table, func() (e error) {
+		exists, e = client.hbase.Exists([]byte(table), tget)
+		return
+	}))
+	return
+}
+
+/**
+ * Test for the existence of columns in the table, as specified by the TGets.
+ *
+ * @return one boolean per TGet, true if the related TGet matches one or more keys
+ *
+ * Parameters:
+ *  - Table: the table to check on
+ *  - Tgets: a list of TGets to check for
+ */
+func (client *HClient2) ExistsAll(table string, tgets []*proto2.TGet) ([]bool, error) {
+	return client.ExistsAllCtx(context.Background(), table, tgets)
+}
+
+/**
+ * ExistsAllCtx is like ExistsAll but honors the deadline and cancellation of ctx.
+ */
+func (client *HClient2) ExistsAllCtx(ctx context.Context, table string, tgets []*proto2.TGet) (exists []bool, err error) {
+	err = checkHbaseError(client.call(ctx, "ExistsAll", table, func() (e error) {
+		exists, e = client.hbase.ExistsAll([]byte(table), tgets)
+		return
+	}))
+	return
+}
+
+/**
+ * Method for getting data from a row.
+ *
+ * If the row cannot be found an empty TResult (no Row, no ColumnValues) is returned.
+ *
+ * Parameters:
+ *  - Table: the table to get from
+ *  - Tget: the TGet to fetch
+ */
+func (client *HClient2) Get(table string, tget *proto2.TGet) (*proto2.TResult, error) {
+	return client.GetCtx(context.Background(), table, tget)
+}
+
+/**
+ * GetCtx is like Get but honors the deadline and cancellation of ctx.
+ */
+func (client *HClient2) GetCtx(ctx context.Context, table string, tget *proto2.TGet) (result *proto2.TResult, err error) {
+	err = checkHbaseError(client.call(ctx, "Get", table, func() (e error) {
+		result, e = client.hbase.Get([]byte(table), tget)
+		return
+	}))
+	return
+}
+
+/**
+ * Method for getting multiple rows.
+ *
+ * The TResults are in the same order as the TGets, a row which cannot be
+ * found has an empty TResult at its position.
+ *
+ * Parameters:
+ *  - Table: the table to get from
+ *  - Tgets: a list of TGets to fetch
+ */
+func (client *HClient2) GetMultiple(table string, tgets []*proto2.TGet) ([]*proto2.TResult, error) {
+	return client.GetMultipleCtx(context.Background(), table, tgets)
+}
+
+/**
+ * GetMultipleCtx is like GetMultiple but honors the deadline and cancellation of ctx.
+ */
+func (client *HClient2) GetMultipleCtx(ctx context.Context, table string, tgets []*proto2.TGet) (results []*proto2.TResult, err error) {
+	err = checkHbaseError(client.call(ctx, "GetMultiple", table, func() (e error) {
+		results, e = client.hbase.GetMultiple([]byte(table), tgets)
+		return
+	}))
+	return
+}
+
+/**
+ * Commit a TPut to a table.
+ *
+ * Parameters:
+ *  - Table: the table to put data in
+ *  - Tput: the TPut to put
+ */
+func (client *HClient2) Put(table string, tput *proto2.TPut) error {
+	return client.PutCtx(context.Background(), table, tput)
+}
+
+/**
+ * PutCtx is like Put but honors the deadline and cancellation of ctx.
+ */
+func (client *HClient2) PutCtx(ctx context.Context, table string, tput *proto2.TPut) error {
+	return checkHbaseError(client.call(ctx, "Put", table, func() error {
+		return client.hbase.Put([]byte(table), tput)
+	}))
+}
+
+/**
+ * Commit a List of Puts to the table.
+ *
+ * Parameters:
+ *  - Table: the table to put data in
+ *  - Tputs: a list of TPuts to commit
+ */
+func (client *HClient2) PutMultiple(table string, tputs []*proto2.TPut) error {
+	return client.PutMultipleCtx(context.Background(), table, tputs)
+}
+
+/**
+ * PutMultipleCtx is like PutMultiple but honors the deadline and cancellation of ctx.
+ */
+func (client *HClient2) PutMultipleCtx(ctx context.Context, table string, tputs []*proto2.TPut) error {
+	return checkHbaseError(client.call(ctx, "PutMultiple", table, func() error {
+		return client.hbase.PutMultiple([]byte(table), tputs)
+	}))
+}
+
+/**
+ * Atomically checks if a row/family/qualifier value matches the expected
+ * value. If it does, it adds the TPut.
+ *
+ * @return true if the new put was executed, false otherwise
+ *
+ * Parameters:
+ *  - Table: to check in and put to
+ *  - Row: row to check
+ *  - Family: column family to check
+ *  - Qualifier: column qualifier to check
+ *  - Value: the expected value, nil means the check is for the
+ * non-existence of the column in question
+ *  - Tput: the TPut to put if the check succeeds
+ */
+func (client *HClient2) CheckAndPut(table string, row []byte, family, qualifier string, value []byte, tput *proto2.TPut) (bool, error) {
+	return client.CheckAndPutCtx(context.Background(), table, row, family, qualifier, value, tput)
+}
+
+/**
+ * CheckAndPutCtx is like CheckAndPut but honors the deadline and cancellation of ctx.
+ */
+func (client *HClient2) CheckAndPutCtx(ctx context.Context, table string, row []byte, family, qualifier string, value []byte, tput *proto2.TPut) (applied bool, err error) {
+	err = checkHbaseError(client.call(ctx, "CheckAndPut", table, func() (e error) {
+		applied, e = client.hbase.CheckAndPut([]byte(table), row, []byte(family), []byte(qualifier), value, tput)
+		return
+	}))
+	return
+}
+
+/**
+ * Deletes as specified by the TDelete.
+ *
+ * Parameters:
+ *  - Table: the table to delete from
+ *  - Tdelete: the TDelete to delete
+ */
+func (client *HClient2) DeleteSingle(table string, tdelete *proto2.TDelete) error {
+	return client.DeleteSingleCtx(context.Background(), table, tdelete)
+}
+
+/**
+ * DeleteSingleCtx is like DeleteSingle but honors the deadline and cancellation of ctx.
+ */
+func (client *HClient2) DeleteSingleCtx(ctx context.Context, table string, tdelete *proto2.TDelete) error {
+	return checkHbaseError(client.call(ctx, "DeleteSingle", table, func() error {
+		return client.hbase.DeleteSingle([]byte(table), tdelete)
+	}))
+}
+
+/**
+ * Bulk commit a List of TDeletes to the table.
+ *
+ * @return the deletes which were not applied (always empty on HBase 2.x,
+ * which fails the whole call instead)
+ *
+ * Parameters:
+ *  - Table: the table to delete from
+ *  - Tdeletes: list of TDeletes to delete
+ */
+func (client *HClient2) DeleteMultiple(table string, tdeletes []*proto2.TDelete) ([]*proto2.TDelete, error) {
+	return client.DeleteMultipleCtx(context.Background(), table, tdeletes)
+}
+
+/**
+ * DeleteMultipleCtx is like DeleteMultiple but honors the deadline and cancellation of ctx.
+ */
+func (client *HClient2) DeleteMultipleCtx(ctx context.Context, table string, tdeletes []*proto2.TDelete) (failed []*proto2.TDelete, err error) {
+	err = checkHbaseError(client.call(ctx, "DeleteMultiple", table, func() (e error) {
+		failed, e = client.hbase.DeleteMultiple([]byte(table), tdeletes)
+		return
+	}))
+	return
+}
+
+/**
+ * Atomically checks if a row/family/qualifier value matches the expected
+ * value. If it does, it adds the delete.
+ *
+ * @return true if the new delete was executed, false otherwise
+ *
+ * Parameters:
+ *  - Table: to check in and delete from
+ *  - Row: row to check
+ *  - Family: column family to check
+ *  - Qualifier: column qualifier to check
+ *  - Value: the expected value, nil means the check is for the
+ * non-existence of the column in question
+ *  - Tdelete: the TDelete to execute if the check succeeds
+ */
+func (client *HClient2) CheckAndDelete(table string, row []byte, family, qualifier string, value []byte, tdelete *proto2.TDelete) (bool, error) {
+	return client.CheckAndDeleteCtx(context.Background(), table, row, family, qualifier, value, tdelete)
+}
+
+/**
+ * CheckAndDeleteCtx is like CheckAndDelete but honors the deadline and cancellation of ctx.
+ */
+func (client *HClient2) CheckAndDeleteCtx(ctx context.Context, table string, row []byte, family, qualifier string, value []byte, tdelete *proto2.TDelete) (applied bool, err error) {
+	err = checkHbaseError(client.call(ctx, "CheckAndDelete", table, func() (e error) {
+		applied, e = client.hbase.CheckAndDelete([]byte(table), row, []byte(family), []byte(qualifier), value, tdelete)
+		return
+	}))
+	return
+}
+
+/**
+ * Atomically checks if a row/family/qualifier value compares to the expected
+ * value with compareOp. If it does, it applies the row mutations.
+ *
+ * @return true if the row was mutated, false otherwise
+ *
+ * Parameters:
+ *  - Table: to check in and mutate
+ *  - Row: row to check
+ *  - Family: column family to check
+ *  - Qualifier: column qualifier to check
+ *  - CompareOp: comparison to make on the value
+ *  - Value: the expected value to be compared against, nil means the check
+ * is for the non-existence of the column in question
+ *  - RowMutations: row mutations to execute if the value matches
+ */
+func (client *HClient2) CheckAndMutate(table string, row []byte, family, qualifier string, compareOp proto2.TCompareOp, value []byte, rowMutations *proto2.TRowMutations) (bool, error) {
+	return client.CheckAndMutateCtx(context.Background(), table, row, family, qualifier, compareOp, value, rowMutations)
+}
+
+/**
+ * CheckAndMutateCtx is like CheckAndMutate but honors the deadline and cancellation of ctx.
+ */
+func (client *HClient2) CheckAndMutateCtx(ctx context.Context, table string, row []byte, family, qualifier string, compareOp proto2.TCompareOp, value []byte, rowMutations *proto2.TRowMutations) (applied bool, err error) {
+	err = checkHbaseError(client.call(ctx, "CheckAndMutate", table, func() (e error) {
+		applied, e = client.hbase.CheckAndMutate([]byte(table), row, []byte(family), []byte(qualifier), compareOp, value, rowMutations)
+		return
+	}))
+	return
+}
+
+/**
+ * Increments the columns of a row.
+ *
+ * @return the values of the columns after the increment
+ *
+ * Parameters:
+ *  - Table: the table to increment the value on
+ *  - Tincrement: the TIncrement to increment
+ */
+func (client *HClient2) Increment(table string, tincrement *proto2.TIncrement) (*proto2.TResult, error) {
+	return client.IncrementCtx(context.Background(), table, tincrement)
+}
+
+/**
+ * IncrementCtx is like Increment but honors the deadline and cancellation of ctx.
+ */
+func (client *HClient2) IncrementCtx(ctx context.Context, table string, tincrement *proto2.TIncrement) (result *proto2.TResult, err error) {
+	err = checkHbaseError(client.call(ctx, "Increment", table, func() (e error) {
+		result, e = client.hbase.Increment([]byte(table), tincrement)
+		return
+	}))
+	return
+}
+
+/**
+ * Appends values to the columns of a row.
+ *
+ * @return the values of the columns after the append
+ *
+ * Parameters:
+ *  - Table: the table to append the value on
+ *  - Tappend: the TAppend to append
+ */
+func (client *HClient2) Append(table string, tappend *proto2.TAppend) (*proto2.TResult, error) {
+	return client.AppendCtx(context.Background(), table, tappend)
+}
+
+/**
+ * AppendCtx is like Append but honors the deadline and cancellation of ctx.
+ */
+func (client *HClient2) AppendCtx(ctx context.Context, table string, tappend *proto2.TAppend) (result *proto2.TResult, err error) {
+	err = checkHbaseError(client.call(ctx, "Append", table, func() (e error) {
+		result, e = client.hbase.Append([]byte(table), tappend)
+		return
+	}))
+	return
+}
+
+/**
+ * mutateRow performs multiple mutations atomically on a single row.
+ *
+ * Parameters:
+ *  - Table: table to apply the mutations
+ *  - TrowMutations: mutations to apply
+ */
+func (client *HClient2) MutateRow(table string, trowMutations *proto2.TRowMutations) error {
+	return client.MutateRowCtx(context.Background(), table, trowMutations)
+}
+
+/**
+ * MutateRowCtx is like MutateRow but honors the deadline and cancellation of ctx.
+ */
+func (client *HClient2) MutateRowCtx(ctx context.Context, table string, trowMutations *proto2.TRowMutations) error {
+	return checkHbaseError(client.call(ctx, "MutateRow", table, func() error {
+		return client.hbase.MutateRow([]byte(table), trowMutations)
+	}))
+}
+
+/**
+ * Get a Scanner for the provided TScan object.
+ *
+ * @return Scanner Id to be used with other scanner procedures
+ *
+ * Parameters:
+ *  - Table: the table to get the Scanner for
+ *  - Tscan: the scan object to get a Scanner for
+ */
+func (client *HClient2) OpenScanner(table string, tscan *proto2.TScan) (int32, error) {
+	return client.OpenScannerCtx(context.Background(), table, tscan)
+}
+
+/**
+ * OpenScannerCtx is like OpenScanner but honors the deadline and cancellation of ctx.
+ */
+func (client *HClient2) OpenScannerCtx(ctx context.Context, table string, tscan *proto2.TScan) (id int32, err error) {
+	err = checkHbaseError(client.call(ctx, "OpenScanner", table, func() (e error) {
+		id, e = client.hbase.OpenScanner([]byte(table), tscan)
+		return
+	}))
+	return
+}
+
+/**
+ * Grabs multiple rows from a Scanner.
+ *
+ * @return Between zero and numRows TResults
+ *
+ * Parameters:
+ *  - ScannerId: the Id of the Scanner to return rows from. This is an Id returned from the openScanner function.
+ *  - NumRows: number of rows to return
+ */
+func (client *HClient2) GetScannerRows(scannerId int32, numRows int32) ([]*proto2.TResult, error) {
+	return client.GetScannerRowsCtx(context.Background(), scannerId, numRows)
+}
+
+/**
+ * GetScannerRowsCtx is like GetScannerRows but honors the deadline and cancellation of ctx.
+ */
+func (client *HClient2) GetScannerRowsCtx(ctx context.Context, scannerId int32, numRows int32) (results []*proto2.TResult, err error) {
+	err = checkHbaseError(client.call(ctx, "GetScannerRows", "", func() (e error) {
+		results, e = client.hbase.GetScannerRows(scannerId, numRows)
+		return
+	}))
+	return
+}
+
+/**
+ * Closes the scanner. Should be called to free server side resources timely.
+ *
+ * Parameters:
+ *  - ScannerId: the Id of the Scanner to close
+ */
+func (client *HClient2) CloseScanner(scannerId int32) error {
+	return client.CloseScannerCtx(context.Background(), scannerId)
+}
+
+/**
+ * CloseScannerCtx is like CloseScanner but honors the deadline and cancellation of ctx.
+ */
+func (client *HClient2) CloseScannerCtx(ctx context.Context, scannerId int32) error {
+	return checkHbaseError(client.call(ctx, "CloseScanner", "", func() error {
+		return client.hbase.CloseScanner(scannerId)
+	}))
+}
+
+/**
+ * Get results for the provided TScan object.
+ * This helper function opens a scanner, get the results and close the scanner.
+ *
+ * @return between zero and numRows TResults
+ *
+ * Parameters:
+ *  - Table: the table to get the Scanner for
+ *  - Tscan: the scan object to get a Scanner for
+ *  - NumRows: number of rows to return
+ */
+func (client *HClient2) GetScannerResults(table string, tscan *proto2.TScan, numRows int32) ([]*proto2.TResult, error) {
+	return client.GetScannerResultsCtx(context.Background(), table, tscan, numRows)
+}
+
+/**
+ * GetScannerResultsCtx is like GetScannerResults but honors the deadline and cancellation of ctx.
+ */
+func (client *HClient2) GetScannerResultsCtx(ctx context.Context, table string, tscan *proto2.TScan, numRows int32) (results []*proto2.TResult, err error) {
+	err = checkHbaseError(client.call(ctx, "GetScannerResults", table, func() (e error) {
+		results, e = client.hbase.GetScannerResults([]byte(table), tscan, numRows)
+		return
+	}))
+	return
+}
+
+/**
+ * Given a table and a row get the location of the region that
+ * would contain the given row key.
+ *
+ * Parameters:
+ *  - Table: the table name
+ *  - Row: the row key
+ *  - Reload: true means the cache will be cleared and the location
+ * will be fetched from meta
+ */
+func (client *HClient2) GetRegionLocation(table string, row []byte, reload bool) (*proto2.THRegionLocation, error) {
+	return client.GetRegionLocationCtx(context.Background(), table, row, reload)
+}
+
+/**
+ * GetRegionLocationCtx is like GetRegionLocation but honors the deadline and cancellation of ctx.
+ */
+func (client *HClient2) GetRegionLocationCtx(ctx context.Context, table string, row []byte, reload bool) (location *proto2.THRegionLocation, err error) {
+	err = checkHbaseError(client.call(ctx, "GetRegionLocation", table, func() (e error) {
+		location, e = client.hbase.GetRegionLocation([]byte(table), row, reload)
+		return
+	}))
+	return
+}
+
+/**
+ * Get all of the region locations for a given table.
+ *
+ * Parameters:
+ *  - Table: the table name
+ */
+func (client *HClient2) GetAllRegionLocations(table string) ([]*proto2.THRegionLocation, error) {
+	return client.GetAllRegionLocationsCtx(context.Background(), table)
+}
+
+/**
+ * GetAllRegionLocationsCtx is like GetAllRegionLocations but honors the deadline and cancellation of ctx.
+ */
+func (client *HClient2) GetAllRegionLocationsCtx(ctx context.Context, table string) (locations []*proto2.THRegionLocation, err error) {
+	err = checkHbaseError(client.call(ctx, "GetAllRegionLocations", table, func() (e error) {
+		locations, e = client.hbase.GetAllRegionLocations([]byte(table))
+		return
+	}))
+	return
+}
+
+/**
+ * @return true if table exists already, false if not
+ *
+ * Parameters:
+ *  - TableName: the table name, "namespace:table" or "table" in the default namespace
+ */
+func (client *HClient2) TableExists(tableName string) (bool, error) {
+	return client.TableExistsCtx(context.Background(), tableName)
+}
+
+/**
+ * TableExistsCtx is like TableExists but honors the deadline and cancellation of ctx.
+ */
+func (client *HClient2) TableExistsCtx(ctx context.Context, tableName string) (exists bool, err error) {
+	err = checkHbaseError(client.call(ctx, "TableExists", tableName, func() (e error) {
+		exists, e = client.hbase.TableExists(NewTTableName(tableName))
+		return
+	}))
+	return
+}
+
+/**
+ * List the tables of a namespace.
+ *
+ * @return the table names as "namespace:table", tables of the default
+ * namespace have no prefix
+ *
+ * Parameters:
+ *  - Namespace: the namespace's name
+ */
+func (client *HClient2) GetTableNamesByNamespace(namespace string) ([]string, error) {
+	return client.GetTableNamesByNamespaceCtx(context.Background(), namespace)
+}
+
+/**
+ * GetTableNamesByNamespaceCtx is like GetTableNamesByNamespace but honors the deadline and cancellation of ctx.
+ */
+func (client *HClient2) GetTableNamesByNamespaceCtx(ctx context.Context, namespace string) (tables []string, err error) {
+	var ret []*proto2.TTableName
+	e1 := client.call(ctx, "GetTableNamesByNamespace", "", func() (e error) {
+		ret, e = client.hbase.GetTableNamesByNamespace(namespace)
+		return
+	})
+	if err = checkHbaseError(e1); err != nil {
+		return
+	}
+
+	tables = make([]string, 0, len(ret))
+	for _, name := range ret {
+		tables = append(tables, fromTTableName(name))
+	}
+	return
+}
+
+/**
+ * Create a new namespace.
+ *
+ * Parameters:
+ *  - Name: the namespace's name
+ *  - Configuration: the namespace's configuration, may be nil
+ */
+func (client *HClient2) CreateNamespace(name string, configuration map[string]string) error {
+	return client.CreateNamespaceCtx(context.Background(), name, configuration)
+}
+
+/**
+ * CreateNamespaceCtx is like CreateNamespace but honors the deadline and cancellation of ctx.
+ */
+func (client *HClient2) CreateNamespaceCtx(ctx context.Context, name string, configuration map[string]string) error {
+	return checkHbaseError(client.call(ctx, "CreateNamespace", "", func() error {
+		return client.hbase.CreateNamespace(&proto2.TNamespaceDescriptor{Name: name, Configuration: configuration})
+	}))
+}
+
+/**
+ * Delete an existing namespace. Only empty namespaces (no tables) can be removed.
+ *
+ * Parameters:
+ *  - Name: the namespace's name
+ */
+func (client *HClient2) DeleteNamespace(name string) error {
+	return client.DeleteNamespaceCtx(context.Background(), name)
+}
+
+/**
+ * DeleteNamespaceCtx is like DeleteNamespace but honors the deadline and cancellation of ctx.
+ */
+func (client *HClient2) DeleteNamespaceCtx(ctx context.Context, name string) error {
+	return checkHbaseError(client.call(ctx, "DeleteNamespace", "", func() error {
+		return client.hbase.DeleteNamespace(name)
+	}))
+}
+
+/**
+ * Get a namespace descriptor by name.
+ *
+ * Parameters:
+ *  - Name: the namespace's name
+ */
+func (client *HClient2) GetNamespaceDescriptor(name string) (*proto2.TNamespaceDescriptor, error) {
+	return client.GetNamespaceDescriptorCtx(context.Background(), name)
+}
+
+/**
+ * GetNamespaceDescriptorCtx is like GetNamespaceDescriptor but honors the deadline and cancellation of ctx.
+ */
+func (client *HClient2) GetNamespaceDescriptorCtx(ctx context.Context, name string) (desc *proto2.TNamespaceDescriptor, err error) {
+	err = checkHbaseError(client.call(ctx, "GetNamespaceDescriptor", "", func() (e error) {
+		desc, e = client.hbase.GetNamespaceDescriptor(name)
+		return
+	}))
+	return
+}
+
+/**
+ * @return all namespaces
+ */
+func (client *HClient2) ListNamespaceDescriptors() ([]*proto2.TNamespaceDescriptor, error) {
+	return client.ListNamespaceDescriptorsCtx(context.Background())
+}
+
+/**
+ * ListNamespaceDescriptorsCtx is like ListNamespaceDescriptors but honors the deadline and cancellation of ctx.
+ */
+func (client *HClient2) ListNamespaceDescriptorsCtx(ctx context.Context) (descs []*proto2.TNamespaceDescriptor, err error) {
+	err = checkHbaseError(client.call(ctx, "ListNamespaceDescriptors", "", func() (e error) {
+		descs, e = client.hbase.ListNamespaceDescriptors()
+		return
+	}))
+	return
+}
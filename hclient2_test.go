@@ -0,0 +1,357 @@
+package gogohbase_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"testing"
+
+	"git.apache.org/thrift.git/lib/go/thrift"
+	goh "github.com/blackbeans/gogobase"
+	"github.com/blackbeans/gogobase/proto2"
+)
+
+// fakeTHBase keeps the rows of a single table t in memory, the rpcs it does
+// not implement panic through the nil THBaseService
+type fakeTHBase struct {
+	proto2.THBaseService
+
+	lock     sync.Mutex
+	rows     map[string]map[string][]byte //row -> family:qualifier -> value
+	scanners map[int32][]string           //scanner id -> rows left
+	nextId   int32
+}
+
+func newFakeTHBase() *fakeTHBase {
+	return &fakeTHBase{rows: make(map[string]map[string][]byte), scanners: make(map[int32][]string)}
+}
+
+func ioError2(message string) error {
+	return &proto2.TIOError{Message: &message}
+}
+
+func (f *fakeTHBase) checkTable(table []byte) error {
+	if string(table) != "t" {
+		return ioError2("table not found: " + string(table))
+	}
+	return nil
+}
+
+func (f *fakeTHBase) result(row string) *proto2.TResult {
+	result := &proto2.TResult{ColumnValues: []*proto2.TColumnValue{}}
+	values, ok := f.rows[row]
+	if !ok {
+		return result
+	}
+	result.Row = []byte(row)
+	columns := make([]string, 0, len(values))
+	for column := range values {
+		columns = append(columns, column)
+	}
+	sort.Strings(columns)
+	for _, column := range columns {
+		i := bytes.IndexByte([]byte(column), ':')
+		result.ColumnValues = append(result.ColumnValues, &proto2.TColumnValue{
+			Family: []byte(column[:i]), Qualifier: []byte(column[i+1:]), Value: values[column],
+		})
+	}
+	return result
+}
+
+func (f *fakeTHBase) put(tput *proto2.TPut) {
+	row := string(tput.Row)
+	if f.rows[row] == nil {
+		f.rows[row] = make(map[string][]byte)
+	}
+	for _, value := range tput.ColumnValues {
+		f.rows[row][string(value.Family)+":"+string(value.Qualifier)] = value.Value
+	}
+}
+
+func (f *fakeTHBase) delete(tdelete *proto2.TDelete) {
+	row := string(tdelete.Row)
+	if len(tdelete.Columns) == 0 {
+		delete(f.rows, row)
+		return
+	}
+	for _, column := range tdelete.Columns {
+		delete(f.rows[row], string(column.Family)+":"+string(column.Qualifier))
+	}
+	if len(f.rows[row]) == 0 {
+		delete(f.rows, row)
+	}
+}
+
+func (f *fakeTHBase) Get(table []byte, tget *proto2.TGet) (*proto2.TResult, error) {
+	if err := f.checkTable(table); err != nil {
+		return nil, err
+	}
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return f.result(string(tget.Row)), nil
+}
+
+func (f *fakeTHBase) Put(table []byte, tput *proto2.TPut) error {
+	if err := f.checkTable(table); err != nil {
+		return err
+	}
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.put(tput)
+	return nil
+}
+
+func (f *fakeTHBase) DeleteSingle(table []byte, tdelete *proto2.TDelete) error {
+	if err := f.checkTable(table); err != nil {
+		return err
+	}
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.delete(tdelete)
+	return nil
+}
+
+func (f *fakeTHBase) CheckAndMutate(table []byte, row []byte, family []byte, qualifier []byte, compareOp proto2.TCompareOp, value []byte, rowMutations *proto2.TRowMutations) (bool, error) {
+	if err := f.checkTable(table); err != nil {
+		return false, err
+	}
+	if compareOp != proto2.TCompareOp_EQUAL {
+		message := "unsupported compare op " + compareOp.String()
+		return false, &proto2.TIllegalArgument{Message: &message}
+	}
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	current, ok := f.rows[string(row)][string(family)+":"+string(qualifier)]
+	if value == nil && ok || value != nil && !bytes.Equal(current, value) {
+		return false, nil
+	}
+	for _, mutation := range rowMutations.Mutations {
+		if mutation.Put != nil {
+			f.put(mutation.Put)
+		}
+		if mutation.DeleteSingle != nil {
+			f.delete(mutation.DeleteSingle)
+		}
+	}
+	return true, nil
+}
+
+func (f *fakeTHBase) OpenScanner(table []byte, tscan *proto2.TScan) (int32, error) {
+	if err := f.checkTable(table); err != nil {
+		return 0, err
+	}
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	var rows []string
+	for row := range f.rows {
+		if row >= string(tscan.StartRow) && (len(tscan.StopRow) == 0 || row < string(tscan.StopRow)) {
+			rows = append(rows, row)
+		}
+	}
+	sort.Strings(rows)
+	f.nextId++
+	f.scanners[f.nextId] = rows
+	return f.nextId, nil
+}
+
+func (f *fakeTHBase) GetScannerRows(scannerId int32, numRows int32) ([]*proto2.TResult, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	rows, ok := f.scanners[scannerId]
+	if !ok {
+		message := "invalid scanner id"
+		return nil, &proto2.TIllegalArgument{Message: &message}
+	}
+	if int(numRows) < len(rows) {
+		rows = rows[:numRows]
+	}
+	f.scanners[scannerId] = f.scanners[scannerId][len(rows):]
+
+	results := make([]*proto2.TResult, 0, len(rows))
+	for _, row := range rows {
+		results = append(results, f.result(row))
+	}
+	return results, nil
+}
+
+func (f *fakeTHBase) CloseScanner(scannerId int32) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if _, ok := f.scanners[scannerId]; !ok {
+		message := "invalid scanner id"
+		return &proto2.TIllegalArgument{Message: &message}
+	}
+	delete(f.scanners, scannerId)
+	return nil
+}
+
+// thrift2Server serves handler through the generated THBaseServiceProcessor on a
+// loopback port and returns a thrift2 pool on it
+func thrift2Server(t *testing.T, handler proto2.THBaseService) (*goh.ThriftPool, func()) {
+	socket, err := thrift.NewTServerSocket("127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := thrift.NewTSimpleServer4(proto2.NewTHBaseServiceProcessor(handler), socket,
+		thrift.NewTTransportFactory(), thrift.NewTBinaryProtocolFactoryDefault())
+	if err := server.Listen(); err != nil {
+		t.Fatal(err)
+	}
+	go server.AcceptLoop()
+
+	pool := goh.NewPool(socket.Addr().String(), goh.WithThrift2())
+	return pool, func() {
+		pool.Destroy()
+		server.Stop()
+	}
+}
+
+func TestThrift2GetPutDelete(t *testing.T) {
+	fake := newFakeTHBase()
+	pool, stop := thrift2Server(t, fake)
+	defer stop()
+
+	err := pool.Do2(func(cli *goh.HClient2) error {
+		put := goh.NewTPut([]byte("row"), goh.NewTColumnValue("cf:a", []byte("1")), goh.NewTColumnValue("cf:b", []byte("2")))
+		if err := cli.Put("t", put); err != nil {
+			return err
+		}
+		result, err := cli.Get("t", goh.NewTGet([]byte("row")))
+		if err != nil {
+			return err
+		}
+		if string(result.Row) != "row" || len(result.ColumnValues) != 2 || string(result.ColumnValues[1].Qualifier) != "b" || string(result.ColumnValues[1].Value) != "2" {
+			t.Fatalf("got %v, want the written row", result)
+		}
+
+		if err := cli.DeleteSingle("t", goh.NewTDelete([]byte("row"), "cf:a")); err != nil {
+			return err
+		}
+		result, err = cli.Get("t", goh.NewTGet([]byte("row")))
+		if err != nil {
+			return err
+		}
+		if len(result.ColumnValues) != 1 || string(result.ColumnValues[0].Qualifier) != "b" {
+			t.Fatalf("got %v, want cf:b left", result)
+		}
+
+		if err := cli.DeleteSingle("t", goh.NewTDelete([]byte("row"))); err != nil {
+			return err
+		}
+		result, err = cli.Get("t", goh.NewTGet([]byte("row")))
+		if err != nil {
+			return err
+		}
+		if result.Row != nil || len(result.ColumnValues) != 0 {
+			t.Fatalf("got %v, want the row deleted", result)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	//the exceptions of the gateway come back as HbaseError
+	err = pool.Do2(func(cli *goh.HClient2) error {
+		_, err := cli.Get("missing", goh.NewTGet([]byte("row")))
+		return err
+	})
+	var herr *goh.HbaseError
+	if !errors.As(err, &herr) || herr.IOErr == nil {
+		t.Fatalf("got %v, want the TIOError of the gateway", err)
+	}
+}
+
+func TestThrift2CheckAndMutate(t *testing.T) {
+	fake := newFakeTHBase()
+	pool, stop := thrift2Server(t, fake)
+	defer stop()
+
+	mutations := &proto2.TRowMutations{Row: []byte("row"), Mutations: []*proto2.TMutation{
+		{Put: goh.NewTPut([]byte("row"), goh.NewTColumnValue("cf:a", []byte("2")))},
+		{DeleteSingle: goh.NewTDelete([]byte("row"), "cf:b")},
+	}}
+	err := pool.Do2(func(cli *goh.HClient2) error {
+		put := goh.NewTPut([]byte("row"), goh.NewTColumnValue("cf:a", []byte("1")), goh.NewTColumnValue("cf:b", []byte("x")))
+		if err := cli.Put("t", put); err != nil {
+			return err
+		}
+
+		applied, err := cli.CheckAndMutate("t", []byte("row"), "cf", "a", proto2.TCompareOp_EQUAL, []byte("0"), mutations)
+		if err != nil {
+			return err
+		}
+		if applied {
+			t.Fatal("mutations applied on a mismatched value")
+		}
+
+		applied, err = cli.CheckAndMutate("t", []byte("row"), "cf", "a", proto2.TCompareOp_EQUAL, []byte("1"), mutations)
+		if err != nil {
+			return err
+		}
+		if !applied {
+			t.Fatal("mutations not applied on the matching value")
+		}
+
+		result, err := cli.Get("t", goh.NewTGet([]byte("row")))
+		if err != nil {
+			return err
+		}
+		if len(result.ColumnValues) != 1 || string(result.ColumnValues[0].Value) != "2" {
+			t.Fatalf("got %v, want cf:a=2 alone", result)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestThrift2Scanner(t *testing.T) {
+	fake := newFakeTHBase()
+	pool, stop := thrift2Server(t, fake)
+	defer stop()
+
+	err := pool.DoContext2(context.Background(), func(cli *goh.HClient2) error {
+		for _, row := range []string{"a", "b", "c", "d", "e"} {
+			if err := cli.Put("t", goh.NewTPut([]byte(row), goh.NewTColumnValue("cf:a", []byte(row)))); err != nil {
+				return err
+			}
+		}
+
+		id, err := cli.OpenScanner("t", &proto2.TScan{StartRow: []byte("b"), StopRow: []byte("e")})
+		if err != nil {
+			return err
+		}
+		var rows []string
+		for {
+			results, err := cli.GetScannerRows(id, 2)
+			if err != nil {
+				return err
+			}
+			if len(results) == 0 {
+				break
+			}
+			for _, result := range results {
+				rows = append(rows, string(result.Row))
+			}
+		}
+		if len(rows) != 3 || rows[0] != "b" || rows[2] != "d" {
+			t.Fatalf("got %v, want b..d", rows)
+		}
+
+		if err := cli.CloseScanner(id); err != nil {
+			return err
+		}
+		if _, err := cli.GetScannerRows(id, 1); err == nil {
+			t.Fatal("read a closed scanner")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,163 @@
+package gogohbase_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net"
+	"testing"
+	"time"
+
+	goh "github.com/blackbeans/gogobase"
+	"github.com/blackbeans/gogobase/hbasetest"
+	"github.com/blackbeans/gogobase/proto"
+)
+
+func TestCheckAndPut(t *testing.T) {
+	srv := hbasetest.NewServer()
+	defer srv.Close()
+	srv.Fake.MustCreateTable("t", "cf")
+
+	pool := goh.NewPool(srv.Addr)
+	defer pool.Destroy()
+
+	err := pool.Do(func(cli *goh.HClient) error {
+		put := &proto.Mutation{Column: proto.Text("cf:a"), Value: proto.Text("v1")}
+		//a nil value expects the column to be missing
+		if applied, err := cli.CheckAndPut("t", []byte("row"), "cf:a", nil, put, nil); err != nil || !applied {
+			t.Fatalf("put of a missing column: applied %v, err %v", applied, err)
+		}
+		if applied, err := cli.CheckAndPut("t", []byte("row"), "cf:a", nil, put, nil); err != nil || applied {
+			t.Fatalf("put of an existing column: applied %v, err %v", applied, err)
+		}
+
+		put = &proto.Mutation{Column: proto.Text("cf:a"), Value: proto.Text("v2")}
+		if applied, err := cli.CheckAndPut("t", []byte("row"), "cf:a", []byte("other"), put, nil); err != nil || applied {
+			t.Fatalf("put with a wrong value: applied %v, err %v", applied, err)
+		}
+		if applied, err := cli.CheckAndPut("t", []byte("row"), "cf:a", []byte("v1"), put, nil); err != nil || !applied {
+			t.Fatalf("put with the current value: applied %v, err %v", applied, err)
+		}
+
+		cells, err := cli.Get("t", []byte("row"), "cf:a", nil)
+		if err != nil {
+			return err
+		}
+		if len(cells) != 1 || string(cells[0].Value) != "v2" {
+			t.Fatalf("got %v, want v2", cells)
+		}
+
+		_, err = cli.CheckAndPut("missing", []byte("row"), "cf:a", nil, put, nil)
+		if !errors.Is(err, goh.ErrTableNotFound) {
+			t.Fatalf("got %v, want ErrTableNotFound", err)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAppend(t *testing.T) {
+	srv := hbasetest.NewServer()
+	defer srv.Close()
+	srv.Fake.MustCreateTable("t", "cf")
+
+	pool := goh.NewPool(srv.Addr)
+	defer pool.Destroy()
+
+	err := pool.Do(func(cli *goh.HClient) error {
+		for _, suffix := range []string{"a", "b"} {
+			if _, err := cli.Append("t", []byte("row"), []string{"cf:log"}, [][]byte{[]byte(suffix)}); err != nil {
+				return err
+			}
+		}
+		cells, err := cli.Append("t", []byte("row"), []string{"cf:log", "cf:other"}, [][]byte{[]byte("c"), []byte("x")})
+		if err != nil {
+			return err
+		}
+		if len(cells) != 2 || string(cells[0].Value) != "abc" || string(cells[1].Value) != "x" {
+			t.Fatalf("got %v, want abc and x", cells)
+		}
+
+		if _, err := cli.Append("t", []byte("row"), []string{"cf:log"}, nil); err == nil {
+			t.Fatal("Append with more columns than values succeeded")
+		}
+		if _, err := cli.Append("t", []byte("row"), nil, nil); err == nil {
+			t.Fatal("Append without columns succeeded")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// silentServer accepts the connections and never answers
+func silentServer(t *testing.T) net.Listener {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go io.Copy(ioutil.Discard, conn)
+		}
+	}()
+	return ln
+}
+
+func TestCallInterruptedByContext(t *testing.T) {
+	ln := silentServer(t)
+	defer ln.Close()
+
+	pool := goh.NewPool(ln.Addr().String())
+	defer pool.Destroy()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	err := pool.DoContext(ctx, func(cli *goh.HClient) error {
+		_, err := cli.GetTableNamesCtx(ctx)
+		return err
+	})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("got %v, want context.DeadlineExceeded", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("the rpc returned %v after the deadline", elapsed)
+	}
+
+	//the interrupted connection is not reused
+	if stats := pool.Stats(); stats.Open != 0 || stats.Idle != 0 {
+		t.Fatalf("the interrupted connection was kept: %+v", stats)
+	}
+}
+
+func TestCallWithCancelledContext(t *testing.T) {
+	srv := hbasetest.NewServer()
+	defer srv.Close()
+
+	pool := goh.NewPool(srv.Addr)
+	defer pool.Destroy()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := pool.Do(func(cli *goh.HClient) error {
+		_, err := cli.GetTableNamesCtx(ctx)
+		return err
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("got %v, want context.Canceled", err)
+	}
+
+	//nothing was sent, the connection goes back to the pool
+	if stats := pool.Stats(); stats.Idle != 1 {
+		t.Fatalf("got %+v, want the connection idle", stats)
+	}
+}
@@ -0,0 +1,177 @@
+package gogohbase
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+const defaultProbeTimeout = 5 * time.Second
+
+// idle connections probed at once by a health check, the others can be borrowed meanwhile
+const maxConcurrentProbes = 4
+
+var errCheckAlive = errors.New("checkAlive failed")
+
+/*
+Probe checks an idle connection with a cheap rpc, ctx carries the timeout of the health check
+*/
+type Probe func(ctx context.Context, c *IdleClient) error
+
+/*
+ProbeTableNames lists the tables: GetTableNames, or GetTableNamesByNamespace
+of the default namespace for thrift2 clients
+*/
+func ProbeTableNames() Probe {
+	return func(ctx context.Context, c *IdleClient) (err error) {
+		if c.Client2 != nil {
+			_, err = c.Client2.GetTableNamesByNamespaceCtx(ctx, defaultNamespace)
+			return
+		}
+		_, err = c.Client.GetTableNamesCtx(ctx)
+		return
+	}
+}
+
+/*
+ProbeTable checks that table is known to the gateway: IsTableEnabled, or
+TableExists for thrift2 clients
+*/
+func ProbeTable(table string) Probe {
+	return func(ctx context.Context, c *IdleClient) (err error) {
+		if c.Client2 != nil {
+			_, err = c.Client2.TableExistsCtx(ctx, table)
+			return
+		}
+		_, err = c.Client.IsTableEnabledCtx(ctx, table)
+		return
+	}
+}
+
+/*
+WithProbe checks the idle connections with probe instead of the checkAlive callback
+*/
+func WithProbe(probe Probe) Option {
+	return func(p *ThriftPool) {
+		p.probe = probe
+	}
+}
+
+/*
+WithHealthCheckTimeout bounds each probe, a connection not answering in time is closed
+*/
+func WithHealthCheckTimeout(d time.Duration) Option {
+	return func(p *ThriftPool) {
+		if d > 0 {
+			p.probeTimeout = d
+		}
+	}
+}
+
+// probing reports whether the health check sends rpcs, it is done out of the lock then
+func (p *ThriftPool) probing() bool {
+	return p.probe != nil || p.checkAlive != nil
+}
+
+// probeIdle probes the connections idle since start which were not probed in this round,
+// taking at most maxConcurrentProbes of them out of the idle list at once. It returns how many were probed.
+func (p *ThriftPool) probeIdle(round int, start time.Time) int {
+	var (
+		wg     sync.WaitGroup
+		lock   sync.Mutex
+		probed int
+	)
+	for i := 0; i < maxConcurrentProbes; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for v := p.nextProbe(round, start); v != nil; v = p.nextProbe(round, start) {
+				p.probeDone(v, p.probeClient(v.c))
+				lock.Lock()
+				probed++
+				lock.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	return probed
+}
+
+// nextProbe takes out of the idle list the next connection to probe in this round, nil when none is left.
+// The connections returned after start have just been used and are not probed.
+func (p *ThriftPool) nextProbe(round int, start time.Time) *idleConn {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.closed {
+		return nil
+	}
+	for ele := p.idle.Front(); nil != ele; ele = ele.Next() {
+		v := ele.Value.(*idleConn)
+		if v.probed != round && !v.t.After(start) {
+			p.idle.Remove(ele)
+			v.probed = round
+			p.checking += 1
+			return v
+		}
+	}
+	return nil
+}
+
+// probeDone puts back the probed connection, or closes it when the probe failed or the pool was closed
+func (p *ThriftPool) probeDone(v *idleConn, err error) {
+	p.lock.Lock()
+	p.checking -= 1
+	reason := ""
+	switch {
+	case p.closed:
+		reason = evictClosed
+	case err != nil:
+		//连续失败的地址会熔断
+		reason = evictCheck
+		p.logger.Info("thrift pool health check failed", "addr", v.c.addr, "err", err)
+		p.balancer.fail(v.c.addr)
+	}
+	if reason != "" {
+		p.release()
+		p.lock.Unlock()
+		p.evict(reason)
+		p.closeClient(v.c)
+		return
+	}
+
+	p.balancer.succeed(v.c.addr)
+	//有等待者直接转交
+	if ele := p.waiters.Front(); nil != ele {
+		p.waiters.Remove(ele)
+		ele.Value.(chan waitResult) <- waitResult{c: v.c}
+	} else {
+		p.idle.PushBack(v)
+	}
+	p.lock.Unlock()
+}
+
+func (p *ThriftPool) probeClient(c *IdleClient) error {
+	if p.probe != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), p.probeTimeout)
+		defer cancel()
+		return p.probe(ctx, c)
+	}
+
+	//thrift2的链接没有checkAlive,只检查传输层
+	if c.Client == nil || p.checkAlive == nil {
+		return nil
+	}
+
+	//checkAlive不接受ctx,用socket的超时限制它
+	conn := c.conn()
+	timeout := conn.timeout
+	conn.SetTimeout(p.probeTimeout)
+	defer conn.SetTimeout(timeout)
+
+	if !p.checkAlive(c.Client) {
+		return errCheckAlive
+	}
+	return nil
+}
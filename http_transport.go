@@ -0,0 +1,114 @@
+package gogohbase
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"git.apache.org/thrift.git/lib/go/thrift"
+)
+
+// httpTransport posts each thrift message to the gateway like thrift.THttpClient,
+// each request is bounded by the ctx of the rpc and by the timeout of the client
+type httpTransport struct {
+	url      string
+	client   *http.Client
+	header   http.Header
+	request  bytes.Buffer
+	response *http.Response
+
+	ctx     context.Context    //ctx of the running rpc, nil means none
+	timeout time.Duration      //bounds each request and its response, 0 means none
+	cancel  context.CancelFunc //releases the ctx of the last request once its response is read
+}
+
+func newHttpPostTransport(url string) *httpTransport {
+	return &httpTransport{
+		url:    url,
+		client: &http.Client{},
+		header: http.Header{"Content-Type": []string{"application/x-thrift"}},
+	}
+}
+
+func (t *httpTransport) Open() error {
+	return nil
+}
+
+func (t *httpTransport) IsOpen() bool {
+	return true
+}
+
+func (t *httpTransport) Close() error {
+	t.request.Reset()
+	return t.closeResponse()
+}
+
+func (t *httpTransport) closeResponse() error {
+	var err error
+	if t.response != nil {
+		err = t.response.Body.Close()
+		t.response = nil
+	}
+	if t.cancel != nil {
+		t.cancel()
+		t.cancel = nil
+	}
+	return err
+}
+
+func (t *httpTransport) Read(buf []byte) (int, error) {
+	if t.response == nil {
+		return 0, thrift.NewTTransportException(thrift.NOT_OPEN, "Response buffer is empty, no request.")
+	}
+	n, err := t.response.Body.Read(buf)
+	if n > 0 && (err == nil || err == io.EOF) {
+		return n, nil
+	}
+	return n, thrift.NewTTransportExceptionFromError(err)
+}
+
+func (t *httpTransport) Write(buf []byte) (int, error) {
+	return t.request.Write(buf)
+}
+
+// Flush posts the buffered message, the response is read by the next Reads
+func (t *httpTransport) Flush() error {
+	t.closeResponse()
+	defer t.request.Reset()
+
+	ctx := t.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if t.timeout > 0 {
+		ctx, t.cancel = context.WithTimeout(ctx, t.timeout)
+	}
+
+	req, err := http.NewRequest("POST", t.url, bytes.NewReader(t.request.Bytes()))
+	if err != nil {
+		return thrift.NewTTransportExceptionFromError(err)
+	}
+	req = req.WithContext(ctx)
+	req.Header = t.header
+
+	response, err := t.client.Do(req)
+	if err != nil {
+		return thrift.NewTTransportExceptionFromError(err)
+	}
+	if response.StatusCode != http.StatusOK {
+		response.Body.Close()
+		return thrift.NewTTransportException(thrift.UNKNOWN_TRANSPORT_EXCEPTION, "HTTP Response code: "+strconv.Itoa(response.StatusCode))
+	}
+	t.response = response
+	return nil
+}
+
+func (t *httpTransport) RemainingBytes() uint64 {
+	if t.response != nil && t.response.ContentLength >= 0 {
+		return uint64(t.response.ContentLength)
+	}
+	return ^uint64(0)
+}
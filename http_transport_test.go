@@ -0,0 +1,117 @@
+package gogohbase_test
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"git.apache.org/thrift.git/lib/go/thrift"
+	goh "github.com/blackbeans/gogobase"
+	"github.com/blackbeans/gogobase/hbasetest"
+	"github.com/blackbeans/gogobase/proto"
+)
+
+// httpGateway serves fake as a thrift http gateway with the binary protocol
+func httpGateway(fake *hbasetest.Fake) *httptest.Server {
+	processor := proto.NewHbaseProcessor(fake)
+	factory := thrift.NewTBinaryProtocolFactoryDefault()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		in := thrift.NewTMemoryBuffer()
+		in.Write(body)
+		out := thrift.NewTMemoryBuffer()
+		processor.Process(factory.GetProtocol(in), factory.GetProtocol(out))
+		w.Header().Set("Content-Type", "application/x-thrift")
+		w.Write(out.Bytes())
+	}))
+}
+
+// blockingGateway answers no request before the client gives up
+func blockingGateway() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		//the server notices the client going away once the body is read
+		ioutil.ReadAll(r.Body)
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+	}))
+}
+
+func TestHttpClient(t *testing.T) {
+	fake := hbasetest.NewFake()
+	fake.MustCreateTable("t", "cf")
+	gateway := httpGateway(fake)
+	defer gateway.Close()
+
+	pool := goh.NewPool(gateway.URL, goh.WithHTTP())
+	defer pool.Destroy()
+
+	err := pool.DoContext(context.Background(), func(cli *goh.HClient) error {
+		mutations := []*proto.Mutation{{Column: proto.Text("cf:a"), Value: proto.Text("v")}}
+		if err := cli.MutateRowCtx(context.Background(), "t", []byte("row"), mutations, nil); err != nil {
+			return err
+		}
+		rows, err := cli.GetRow("t", []byte("row"), nil)
+		if err != nil {
+			return err
+		}
+		if len(rows) != 1 || string(rows[0].Columns["cf:a"].Value) != "v" {
+			t.Fatalf("got %v, want the written row", rows)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestHttpCallInterruptedByContext(t *testing.T) {
+	gateway := blockingGateway()
+	defer gateway.Close()
+
+	pool := goh.NewPool(gateway.URL, goh.WithHTTP())
+	defer pool.Destroy()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	err := pool.DoContext(ctx, func(cli *goh.HClient) error {
+		_, err := cli.GetTableNamesCtx(ctx)
+		return err
+	})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("got %v, want context.DeadlineExceeded", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("the http rpc returned %v after the deadline", elapsed)
+	}
+}
+
+func TestHttpTimeout(t *testing.T) {
+	gateway := blockingGateway()
+	defer gateway.Close()
+
+	pool := goh.NewPool(gateway.URL, goh.WithHTTP(), goh.WithTimeout(50*time.Millisecond))
+	defer pool.Destroy()
+
+	start := time.Now()
+	err := pool.Do(func(cli *goh.HClient) error {
+		_, err := cli.GetTableNames()
+		return err
+	})
+	if err == nil {
+		t.Fatal("rpc to a blocked gateway succeeded")
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("the http rpc returned after %v, want the 50ms timeout", elapsed)
+	}
+}
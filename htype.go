@@ -277,6 +277,31 @@ func NewTIncrement(table string, row []byte, column string, ammount int64) *prot
 	}
 }
 
+// /**
+//  * An Append object is used to specify the parameters for performing the append operation.
+//  *
+//  * Attributes:
+//  *  - Table
+//  *  - Row
+//  *  - Columns
+//  *  - Values
+//  */
+// type TAppend struct {
+// 	Table   []byte   "table"   // 1
+// 	Row     []byte   "row"     // 2
+// 	Columns [][]byte "columns" // 3
+// 	Values  [][]byte "values"  // 4
+// }
+
+func NewTAppend(table string, row []byte, columns []string, values [][]byte) *proto.TAppend {
+	return &proto.TAppend{
+		Table:   proto.Text(table),
+		Row:     proto.Text(row),
+		Columns: toHbaseTextList(columns),
+		Values:  values,
+	}
+}
+
 /**
  * Holds row name and then a map of columns to cells.
  *
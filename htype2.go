@@ -0,0 +1,115 @@
+package gogohbase
+
+import (
+	"strings"
+
+	"github.com/blackbeans/gogobase/proto2"
+)
+
+const defaultNamespace = "default"
+
+/*
+splitColumn splits "family:qualifier", the qualifier is nil for "family"
+*/
+func splitColumn(column string) (family, qualifier []byte) {
+	i := strings.IndexByte(column, ':')
+	if i < 0 {
+		return []byte(column), nil
+	}
+	return []byte(column[:i]), []byte(column[i+1:])
+}
+
+/*
+NewTColumn returns the thrift2 column of "family:qualifier", or of the whole family for "family"
+*/
+func NewTColumn(column string) *proto2.TColumn {
+	family, qualifier := splitColumn(column)
+	return &proto2.TColumn{
+		Family:    family,
+		Qualifier: qualifier,
+	}
+}
+
+/*
+NewTColumnValue returns the value of the cell "family:qualifier", the timestamp
+is left to the server
+*/
+func NewTColumnValue(column string, value []byte) *proto2.TColumnValue {
+	family, qualifier := splitColumn(column)
+	if qualifier == nil {
+		qualifier = []byte{}
+	}
+	return &proto2.TColumnValue{
+		Family:    family,
+		Qualifier: qualifier,
+		Value:     value,
+	}
+}
+
+/*
+NewTGet returns a get of the latest version of columns ("family:qualifier" or
+"family"), or of the whole row if no column is given
+*/
+func NewTGet(row []byte, columns ...string) *proto2.TGet {
+	get := proto2.NewTGet()
+	get.Row = row
+	get.Columns = toTColumns(columns)
+	return get
+}
+
+/*
+NewTPut returns a put of values into row
+*/
+func NewTPut(row []byte, values ...*proto2.TColumnValue) *proto2.TPut {
+	put := proto2.NewTPut()
+	put.Row = row
+	put.ColumnValues = values
+	if put.ColumnValues == nil {
+		put.ColumnValues = []*proto2.TColumnValue{}
+	}
+	return put
+}
+
+/*
+NewTDelete returns a delete of all versions of columns ("family:qualifier" or
+"family"), or of the whole row if no column is given
+*/
+func NewTDelete(row []byte, columns ...string) *proto2.TDelete {
+	del := proto2.NewTDelete()
+	del.Row = row
+	del.Columns = toTColumns(columns)
+	return del
+}
+
+/*
+NewTTableName returns the thrift2 name of "namespace:table", or of "table" in the default namespace
+*/
+func NewTTableName(name string) *proto2.TTableName {
+	ns, qualifier := splitColumn(name)
+	if qualifier == nil {
+		ns, qualifier = []byte(defaultNamespace), ns
+	}
+	return &proto2.TTableName{
+		Ns:        ns,
+		Qualifier: qualifier,
+	}
+}
+
+func fromTTableName(name *proto2.TTableName) string {
+	if len(name.Ns) == 0 || string(name.Ns) == defaultNamespace {
+		return string(name.Qualifier)
+	}
+	return string(name.Ns) + ":" + string(name.Qualifier)
+}
+
+func toTColumns(columns []string) []*proto2.TColumn {
+	if len(columns) == 0 {
+		return nil
+	}
+
+	data := make([]*proto2.TColumn, len(columns))
+	for i, column := range columns {
+		data[i] = NewTColumn(column)
+	}
+	return data
+}
@@ -0,0 +1,181 @@
+package gogohbase
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"runtime/debug"
+	"sort"
+	"sync/atomic"
+	"time"
+)
+
+var ErrReclaimed = errors.New("Connection was reclaimed by the leak detector")
+
+/*
+BorrowedConn is a connection borrowed from the pool and not returned yet
+*/
+type BorrowedConn struct {
+	Addr  string
+	Since time.Time     //time of the Get
+	Held  time.Duration //how long it has been borrowed
+	Stack string        //stack trace of the Get
+}
+
+// borrow of a client, recorded when the leak detection is on
+type borrow struct {
+	t        time.Time
+	stack    []byte
+	reported bool //already logged as leaked
+}
+
+/*
+WithLeakDetection records the stack trace and the time of every Get. The health
+check logs the connections borrowed for longer than threshold and counts them in
+Stats and Metrics, BorrowedConns and DumpBorrowed list the borrowed connections.
+*/
+func WithLeakDetection(threshold time.Duration) Option {
+	return func(p *ThriftPool) {
+		p.leakThreshold = threshold
+		p.borrowed = make(map[*IdleClient]*borrow)
+	}
+}
+
+/*
+WithLeakReclaim frees the connections borrowed for longer than d: their socket is
+closed so that pending rpcs fail, and their slot is given back to the pool. A
+reclaimed connection is closed when returned, Put returns ErrReclaimed.
+Implies WithLeakDetection(d) unless a shorter threshold is set.
+*/
+func WithLeakReclaim(d time.Duration) Option {
+	return func(p *ThriftPool) {
+		p.leakReclaim = d
+		if p.leakThreshold <= 0 || p.leakThreshold > d {
+			p.leakThreshold = d
+		}
+		if p.borrowed == nil {
+			p.borrowed = make(map[*IdleClient]*borrow)
+		}
+	}
+}
+
+// borrow records the Get of client
+func (p *ThriftPool) borrow(client *IdleClient) {
+	if p.borrowed == nil {
+		return
+	}
+
+	b := &borrow{t: nowFunc(), stack: debug.Stack()}
+	p.lock.Lock()
+	p.borrowed[client] = b
+	p.lock.Unlock()
+}
+
+// unborrow forgets the borrow of client and reports whether it was reclaimed, the lock must be held
+func (p *ThriftPool) unborrow(client *IdleClient) bool {
+	if p.borrowed == nil {
+		return false
+	}
+
+	delete(p.borrowed, client)
+	if client.reclaimed {
+		client.reclaimed = false
+		return true
+	}
+	return false
+}
+
+// reclaimed reports whether client was reclaimed by the leak detector
+func (p *ThriftPool) reclaimed(client *IdleClient) bool {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return client.reclaimed
+}
+
+// detectLeaks logs the connections borrowed for longer than the threshold and reclaims the ones over leakReclaim
+func (p *ThriftPool) detectLeaks() {
+	if p.borrowed == nil {
+		return
+	}
+
+	now := nowFunc()
+	var leaks []BorrowedConn
+	var reclaims []*IdleClient
+	p.lock.Lock()
+	for c, b := range p.borrowed {
+		held := now.Sub(b.t)
+		if !b.reported && held >= p.leakThreshold {
+			b.reported = true
+			leaks = append(leaks, b.conn(c, now))
+		}
+		if p.leakReclaim > 0 && held >= p.leakReclaim {
+			delete(p.borrowed, c)
+			c.reclaimed = true
+			p.release()
+			reclaims = append(reclaims, c)
+		}
+	}
+	p.lock.Unlock()
+
+	for _, leak := range leaks {
+		atomic.AddInt64(&p.stats.leaked, 1)
+		p.metrics.leak()
+		p.logger.Warn("thrift pool connection leaked", "addr", leak.Addr, "held", leak.Held, "stack", leak.Stack)
+	}
+
+	for _, c := range reclaims {
+		p.evict(evictLeaked)
+		p.logger.Warn("thrift pool reclaimed leaked connection", "addr", c.addr)
+		p.balancer.closed(c.addr)
+		//只关闭底层的socket,借用方还可能在使用这个链接
+		if conn := c.conn(); conn != nil && conn.socket != nil {
+			if nc := conn.socket.Conn(); nc != nil {
+				nc.Close()
+			}
+		}
+	}
+}
+
+func (b *borrow) conn(c *IdleClient, now time.Time) BorrowedConn {
+	return BorrowedConn{
+		Addr:  c.addr,
+		Since: b.t,
+		Held:  now.Sub(b.t),
+		Stack: string(b.stack),
+	}
+}
+
+/*
+BorrowedConns lists the borrowed connections, the longest held first.
+It is empty unless WithLeakDetection is set.
+*/
+func (p *ThriftPool) BorrowedConns() []BorrowedConn {
+	now := nowFunc()
+	p.lock.RLock()
+	conns := make([]BorrowedConn, 0, len(p.borrowed))
+	for c, b := range p.borrowed {
+		conns = append(conns, b.conn(c, now))
+	}
+	p.lock.RUnlock()
+
+	sort.Slice(conns, func(i, j int) bool {
+		return conns[i].Since.Before(conns[j].Since)
+	})
+	return conns
+}
+
+/*
+DumpBorrowed writes the borrowed connections and the stack traces of their Get to w
+*/
+func (p *ThriftPool) DumpBorrowed(w io.Writer) error {
+	conns := p.BorrowedConns()
+	if _, err := fmt.Fprintf(w, "%d borrowed connections\n", len(conns)); err != nil {
+		return err
+	}
+	for _, c := range conns {
+		if _, err := fmt.Fprintf(w, "\n%s held for %v since %s\n%s", c.Addr, c.Held, c.Since.Format(time.RFC3339), c.Stack); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,32 @@
+package gogohbase
+
+/*
+Logger receives the diagnostics of the pool as a message and alternating
+key/value pairs, like log/slog. *slog.Logger implements it, see NewSlogLogger.
+*/
+type Logger interface {
+	Debug(msg string, keysAndValues ...interface{})
+	Info(msg string, keysAndValues ...interface{})
+	Warn(msg string, keysAndValues ...interface{})
+	Error(msg string, keysAndValues ...interface{})
+}
+
+// nopLogger discards everything, it is the default of the pools
+type nopLogger struct{}
+
+func (nopLogger) Debug(msg string, keysAndValues ...interface{}) {}
+func (nopLogger) Info(msg string, keysAndValues ...interface{})  {}
+func (nopLogger) Warn(msg string, keysAndValues ...interface{})  {}
+func (nopLogger) Error(msg string, keysAndValues ...interface{}) {}
+
+/*
+WithLogger sends the diagnostics of the pool to logger, they are discarded by default
+*/
+func WithLogger(logger Logger) Option {
+	return func(p *ThriftPool) {
+		if logger == nil {
+			logger = nopLogger{}
+		}
+		p.logger = logger
+	}
+}
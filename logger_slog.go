@@ -0,0 +1,18 @@
+//go:build go1.21
+
+package gogohbase
+
+import "log/slog"
+
+/*
+NewSlogLogger returns a Logger writing to l, or to slog.Default() when l is nil:
+
+	pool := goh.NewThriftPool(ctx, addr, 100, 60, 30*time.Second, dial, closeFunc, checkAlive,
+		goh.WithLogger(goh.NewSlogLogger(slog.Default().With("component", "hbase"))))
+*/
+func NewSlogLogger(l *slog.Logger) Logger {
+	if l == nil {
+		l = slog.Default()
+	}
+	return l
+}
@@ -0,0 +1,520 @@
+package gogohbase
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/blackbeans/gogobase/proto"
+)
+
+/*
+Struct mapping
+
+Fields are mapped to columns with the "hbase" tag:
+
+	type User struct {
+		Id      string            `hbase:"rowkey"`
+		Name    string            `hbase:"info:name"`
+		Age     int32             `hbase:"info:age,omitempty"`
+		Tags    map[string]string `hbase:"info:tags,json"`
+		Updated int64             `hbase:"info:name,timestamp"`
+	}
+
+Values are encoded like org.apache.hadoop.hbase.util.Bytes.toBytes, so they can
+be shared with java clients:
+
+	string, []byte          raw bytes
+	int64, int, uint64,...  big-endian, 8 bytes for int64/int/uint64/uint,
+	                        4 for int32/uint32, 2 for int16/uint16, 1 for int8/uint8
+	float64, float32        big-endian IEEE 754, 8 or 4 bytes
+	bool                    1 byte, 0xff for true and 0x00 for false
+	time.Time               int64 milliseconds since the epoch
+
+Pointers to these types are allowed, a nil pointer is not written. Any other
+type needs the "json" option, which stores the value as a JSON document.
+
+Options:
+
+	rowkey     the field (string or []byte) holds the row key
+	omitempty  zero values are not written
+	json       the value is stored as JSON
+	timestamp  the field (int64 milliseconds or time.Time) receives the timestamp
+	           of the cell, it is never written
+	"-"        the field is ignored
+*/
+
+const (
+	tagName    = "hbase"
+	tagRowKey  = "rowkey"
+	tagOmit    = "omitempty"
+	tagJSON    = "json"
+	tagTs      = "timestamp"
+	tagIgnored = "-"
+)
+
+var (
+	ErrNoRowKey = errors.New("Struct has no row key")
+)
+
+var (
+	timeType  = reflect.TypeOf(time.Time{})
+	bytesType = reflect.TypeOf([]byte(nil))
+)
+
+/*
+fieldInfo is a tagged field of a struct
+*/
+type fieldInfo struct {
+	name      string
+	index     []int
+	column    string
+	rowkey    bool
+	omitEmpty bool
+	json      bool
+	timestamp bool
+}
+
+// reflect.Type -> []*fieldInfo
+var fieldCache sync.Map
+
+/*
+Marshal returns the BatchMutation of the struct pointed to by v, the row is
+taken from the field tagged "rowkey".
+*/
+func Marshal(v interface{}) (*proto.BatchMutation, error) {
+	rv, fields, err := structOf(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var row []byte
+	for _, f := range fields {
+		if f.rowkey {
+			row = rowKeyOf(rv.FieldByIndex(f.index))
+			break
+		}
+	}
+	if len(row) == 0 {
+		return nil, ErrNoRowKey
+	}
+
+	mutations, err := marshalFields(rv, fields)
+	if err != nil {
+		return nil, err
+	}
+	return NewBatchMutation(row, mutations), nil
+}
+
+/*
+MarshalMutations returns the mutations of the struct pointed to by v, for MutateRow.
+*/
+func MarshalMutations(v interface{}) ([]*proto.Mutation, error) {
+	rv, fields, err := structOf(v)
+	if err != nil {
+		return nil, err
+	}
+	return marshalFields(rv, fields)
+}
+
+/*
+Unmarshal decodes the row of GetRow/ScannerGetList into the struct pointed to by v.
+Fields whose column is missing in the row are left untouched.
+*/
+func Unmarshal(result *proto.TRowResult_, v interface{}) error {
+	rv, fields, err := structOf(v)
+	if err != nil {
+		return err
+	}
+	return unmarshalRow(result, rv, fields)
+}
+
+/*
+UnmarshalRows appends the rows of GetRows/ScannerGetList to the slice pointed to by v,
+the elements of the slice are structs or pointers to structs.
+*/
+func UnmarshalRows(results []*proto.TRowResult_, v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Slice {
+		return fmt.Errorf("UnmarshalRows: want a pointer to a slice, got %T", v)
+	}
+
+	slice := rv.Elem()
+	elem := slice.Type().Elem()
+	isPtr := elem.Kind() == reflect.Ptr
+	if isPtr {
+		elem = elem.Elem()
+	}
+	if elem.Kind() != reflect.Struct {
+		return fmt.Errorf("UnmarshalRows: want a slice of structs, got %T", v)
+	}
+
+	fields, err := cachedFields(elem)
+	if err != nil {
+		return err
+	}
+
+	for _, result := range results {
+		item := reflect.New(elem)
+		if err := unmarshalRow(result, item.Elem(), fields); err != nil {
+			return err
+		}
+		if isPtr {
+			slice = reflect.Append(slice, item)
+		} else {
+			slice = reflect.Append(slice, item.Elem())
+		}
+	}
+	rv.Elem().Set(slice)
+	return nil
+}
+
+func structOf(v interface{}) (reflect.Value, []*fieldInfo, error) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return reflect.Value{}, nil, fmt.Errorf("want a pointer to a struct, got %T", v)
+	}
+
+	rv = rv.Elem()
+	fields, err := cachedFields(rv.Type())
+	return rv, fields, err
+}
+
+func marshalFields(rv reflect.Value, fields []*fieldInfo) ([]*proto.Mutation, error) {
+	mutations := make([]*proto.Mutation, 0, len(fields))
+	for _, f := range fields {
+		if f.rowkey || f.timestamp {
+			continue
+		}
+
+		fv := rv.FieldByIndex(f.index)
+		if f.omitEmpty && fv.IsZero() {
+			continue
+		}
+		if fv.Kind() == reflect.Ptr {
+			if fv.IsNil() {
+				continue
+			}
+			fv = fv.Elem()
+		}
+
+		value, err := encodeValue(fv, f)
+		if err != nil {
+			return nil, err
+		}
+		mutations = append(mutations, NewMutation(f.column, value))
+	}
+	return mutations, nil
+}
+
+func unmarshalRow(result *proto.TRowResult_, rv reflect.Value, fields []*fieldInfo) error {
+	if result == nil {
+		return nil
+	}
+
+	cells := result.Columns
+	if cells == nil && len(result.SortedColumns) > 0 {
+		cells = make(map[string]*proto.TCell, len(result.SortedColumns))
+		for _, col := range result.SortedColumns {
+			cells[string(col.ColumnName)] = col.Cell
+		}
+	}
+
+	for _, f := range fields {
+		fv := rv.FieldByIndex(f.index)
+		if f.rowkey {
+			if fv.Kind() == reflect.String {
+				fv.SetString(string(result.Row))
+			} else {
+				fv.SetBytes(append([]byte(nil), result.Row...))
+			}
+			continue
+		}
+
+		cell, ok := cells[f.column]
+		if !ok || cell == nil {
+			continue
+		}
+
+		if f.timestamp {
+			if fv.Type() == timeType {
+				fv.Set(reflect.ValueOf(msToTime(cell.Timestamp)))
+			} else {
+				fv.SetInt(cell.Timestamp)
+			}
+			continue
+		}
+
+		if fv.Kind() == reflect.Ptr {
+			if fv.IsNil() {
+				fv.Set(reflect.New(fv.Type().Elem()))
+			}
+			fv = fv.Elem()
+		}
+		if err := decodeValue(cell.Value, fv, f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func rowKeyOf(v reflect.Value) []byte {
+	if v.Kind() == reflect.String {
+		return []byte(v.String())
+	}
+	return v.Bytes()
+}
+
+func cachedFields(t reflect.Type) ([]*fieldInfo, error) {
+	if fields, ok := fieldCache.Load(t); ok {
+		return fields.([]*fieldInfo), nil
+	}
+
+	fields, err := typeFields(t, nil)
+	if err != nil {
+		return nil, err
+	}
+	fieldCache.Store(t, fields)
+	return fields, nil
+}
+
+/*
+typeFields lists the tagged fields of t, untagged embedded structs are flattened
+*/
+func typeFields(t reflect.Type, index []int) ([]*fieldInfo, error) {
+	var fields []*fieldInfo
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		idx := append(append([]int(nil), index...), i)
+
+		tag, tagged := sf.Tag.Lookup(tagName)
+		if !tagged {
+			if sf.Anonymous && sf.Type.Kind() == reflect.Struct {
+				embedded, err := typeFields(sf.Type, idx)
+				if err != nil {
+					return nil, err
+				}
+				fields = append(fields, embedded...)
+			}
+			continue
+		}
+		if tag == tagIgnored {
+			continue
+		}
+		if sf.PkgPath != "" {
+			return nil, fmt.Errorf("%s.%s: unexported field is tagged", t, sf.Name)
+		}
+
+		f, err := parseField(sf, tag)
+		if err != nil {
+			return nil, fmt.Errorf("%s.%s: %v", t, sf.Name, err)
+		}
+		f.index = idx
+		fields = append(fields, f)
+	}
+	return fields, nil
+}
+
+func parseField(sf reflect.StructField, tag string) (*fieldInfo, error) {
+	parts := strings.Split(tag, ",")
+	f := &fieldInfo{name: sf.Name, column: parts[0]}
+	for _, opt := range parts[1:] {
+		switch opt {
+		case tagOmit:
+			f.omitEmpty = true
+		case tagJSON:
+			f.json = true
+		case tagTs:
+			f.timestamp = true
+		default:
+			return nil, fmt.Errorf("unknown option %q", opt)
+		}
+	}
+
+	t := sf.Type
+	if f.column == tagRowKey {
+		f.rowkey = true
+		if t.Kind() != reflect.String && t != bytesType {
+			return nil, fmt.Errorf("row key must be a string or []byte, got %s", t)
+		}
+		return f, nil
+	}
+
+	if !strings.Contains(f.column, ":") {
+		return nil, fmt.Errorf("column %q is not \"family:qualifier\"", f.column)
+	}
+
+	if f.timestamp {
+		if t.Kind() != reflect.Int64 && t != timeType {
+			return nil, fmt.Errorf("timestamp must be an int64 or time.Time, got %s", t)
+		}
+		return f, nil
+	}
+
+	if f.json {
+		return f, nil
+	}
+
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if !isScalar(t) {
+		return nil, fmt.Errorf("unsupported type %s, use the json option", sf.Type)
+	}
+	return f, nil
+}
+
+func isScalar(t reflect.Type) bool {
+	if t == timeType || t == bytesType {
+		return true
+	}
+
+	switch t.Kind() {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+func encodeValue(v reflect.Value, f *fieldInfo) ([]byte, error) {
+	if f.json {
+		b, err := json.Marshal(v.Interface())
+		if err != nil {
+			return nil, fmt.Errorf("column %s: %v", f.column, err)
+		}
+		return b, nil
+	}
+
+	if v.Type() == timeType {
+		return encodeUint(uint64(timeToMs(v.Interface().(time.Time))), 8), nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return []byte(v.String()), nil
+	case reflect.Slice:
+		return v.Bytes(), nil
+	case reflect.Bool:
+		if v.Bool() {
+			return []byte{0xff}, nil
+		}
+		return []byte{0x00}, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return encodeUint(uint64(v.Int()), intSize(v.Type())), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return encodeUint(v.Uint(), intSize(v.Type())), nil
+	case reflect.Float32:
+		return encodeUint(uint64(math.Float32bits(float32(v.Float()))), 4), nil
+	case reflect.Float64:
+		return encodeUint(math.Float64bits(v.Float()), 8), nil
+	}
+	return nil, fmt.Errorf("column %s: unsupported type %s", f.column, v.Type())
+}
+
+func decodeValue(b []byte, v reflect.Value, f *fieldInfo) error {
+	if f.json {
+		if err := json.Unmarshal(b, v.Addr().Interface()); err != nil {
+			return fmt.Errorf("column %s: %v", f.column, err)
+		}
+		return nil
+	}
+
+	if v.Type() == timeType {
+		n, err := decodeUint(b, 8, f)
+		if err != nil {
+			return err
+		}
+		v.Set(reflect.ValueOf(msToTime(int64(n))))
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(string(b))
+	case reflect.Slice:
+		v.SetBytes(append([]byte(nil), b...))
+	case reflect.Bool:
+		if len(b) != 1 {
+			return fmt.Errorf("column %s: want 1 byte for bool, got %d", f.column, len(b))
+		}
+		v.SetBool(b[0] != 0)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		size := intSize(v.Type())
+		n, err := decodeUint(b, size, f)
+		if err != nil {
+			return err
+		}
+		//sign extension of the narrower ints
+		shift := uint(64 - 8*size)
+		i := int64(n<<shift) >> shift
+		if v.OverflowInt(i) {
+			return fmt.Errorf("column %s: %d overflows %s", f.column, i, v.Type())
+		}
+		v.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := decodeUint(b, intSize(v.Type()), f)
+		if err != nil {
+			return err
+		}
+		if v.OverflowUint(n) {
+			return fmt.Errorf("column %s: %d overflows %s", f.column, n, v.Type())
+		}
+		v.SetUint(n)
+	case reflect.Float32:
+		n, err := decodeUint(b, 4, f)
+		if err != nil {
+			return err
+		}
+		v.SetFloat(float64(math.Float32frombits(uint32(n))))
+	case reflect.Float64:
+		n, err := decodeUint(b, 8, f)
+		if err != nil {
+			return err
+		}
+		v.SetFloat(math.Float64frombits(n))
+	default:
+		return fmt.Errorf("column %s: unsupported type %s", f.column, v.Type())
+	}
+	return nil
+}
+
+// intSize is the encoded size of an integer type, int and uint take 8 bytes on every platform
+func intSize(t reflect.Type) int {
+	if t.Kind() == reflect.Int || t.Kind() == reflect.Uint {
+		return 8
+	}
+	return int(t.Size())
+}
+
+func encodeUint(n uint64, size int) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, n)
+	return b[8-size:]
+}
+
+func decodeUint(b []byte, size int, f *fieldInfo) (uint64, error) {
+	if len(b) != size {
+		return 0, fmt.Errorf("column %s: want %d bytes for %s, got %d", f.column, size, f.name, len(b))
+	}
+
+	var buf [8]byte
+	copy(buf[8-size:], b)
+	return binary.BigEndian.Uint64(buf[:]), nil
+}
+
+func timeToMs(t time.Time) int64 {
+	return t.UnixNano() / int64(time.Millisecond)
+}
+
+func msToTime(ms int64) time.Time {
+	return time.Unix(ms/1000, (ms%1000)*int64(time.Millisecond))
+}
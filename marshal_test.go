@@ -0,0 +1,331 @@
+package gogohbase_test
+
+import (
+	"bytes"
+	"math"
+	"reflect"
+	"testing"
+	"time"
+
+	goh "github.com/blackbeans/gogobase"
+	"github.com/blackbeans/gogobase/proto"
+)
+
+type scalars struct {
+	Key     string    `hbase:"rowkey"`
+	Str     string    `hbase:"cf:str"`
+	Bytes   []byte    `hbase:"cf:bytes"`
+	Int     int       `hbase:"cf:int"`
+	Int8    int8      `hbase:"cf:int8"`
+	Int16   int16     `hbase:"cf:int16"`
+	Int32   int32     `hbase:"cf:int32"`
+	Int64   int64     `hbase:"cf:int64"`
+	Uint    uint      `hbase:"cf:uint"`
+	Uint8   uint8     `hbase:"cf:uint8"`
+	Uint16  uint16    `hbase:"cf:uint16"`
+	Uint32  uint32    `hbase:"cf:uint32"`
+	Uint64  uint64    `hbase:"cf:uint64"`
+	Float32 float32   `hbase:"cf:float32"`
+	Float64 float64   `hbase:"cf:float64"`
+	Bool    bool      `hbase:"cf:bool"`
+	Time    time.Time `hbase:"cf:time"`
+	Ignored string    `hbase:"-"`
+}
+
+// rowOf returns the row GetRow would read after writing m at ts
+func rowOf(m *proto.BatchMutation, ts int64) *proto.TRowResult_ {
+	row := &proto.TRowResult_{Row: m.Row, Columns: make(map[string]*proto.TCell)}
+	for _, mutation := range m.Mutations {
+		row.Columns[string(mutation.Column)] = &proto.TCell{Value: proto.Bytes(mutation.Value), Timestamp: ts}
+	}
+	return row
+}
+
+// columns maps the columns of m to their values
+func columns(m *proto.BatchMutation) map[string][]byte {
+	values := make(map[string][]byte, len(m.Mutations))
+	for _, mutation := range m.Mutations {
+		values[string(mutation.Column)] = []byte(mutation.Value)
+	}
+	return values
+}
+
+func TestMarshalEncoding(t *testing.T) {
+	m, err := goh.Marshal(&scalars{
+		Key:     "row",
+		Str:     "s",
+		Bytes:   []byte{1, 2},
+		Int:     1,
+		Int8:    -1,
+		Int16:   -2,
+		Int32:   -3,
+		Int64:   -4,
+		Uint:    1,
+		Uint8:   0xfe,
+		Uint16:  0x0102,
+		Uint32:  0x01020304,
+		Uint64:  1,
+		Float32: 1.5,
+		Float64: -2,
+		Bool:    true,
+		Time:    time.Unix(1, int64(500*time.Millisecond)),
+		Ignored: "not written",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(m.Row) != "row" {
+		t.Fatalf("row key %q", m.Row)
+	}
+
+	values := columns(m)
+	tests := []struct {
+		column string
+		want   []byte
+	}{
+		{"cf:str", []byte("s")},
+		{"cf:bytes", []byte{1, 2}},
+		{"cf:int", []byte{0, 0, 0, 0, 0, 0, 0, 1}},
+		{"cf:int8", []byte{0xff}},
+		{"cf:int16", []byte{0xff, 0xfe}},
+		{"cf:int32", []byte{0xff, 0xff, 0xff, 0xfd}},
+		{"cf:int64", []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xfc}},
+		{"cf:uint", []byte{0, 0, 0, 0, 0, 0, 0, 1}},
+		{"cf:uint8", []byte{0xfe}},
+		{"cf:uint16", []byte{1, 2}},
+		{"cf:uint32", []byte{1, 2, 3, 4}},
+		{"cf:uint64", []byte{0, 0, 0, 0, 0, 0, 0, 1}},
+		{"cf:float32", []byte{0x3f, 0xc0, 0, 0}},
+		{"cf:float64", []byte{0xc0, 0, 0, 0, 0, 0, 0, 0}},
+		{"cf:bool", []byte{0xff}},
+		{"cf:time", []byte{0, 0, 0, 0, 0, 0, 0x05, 0xdc}},
+	}
+	for _, tt := range tests {
+		if got := values[tt.column]; !bytes.Equal(got, tt.want) {
+			t.Errorf("%s: got %x, want %x", tt.column, got, tt.want)
+		}
+	}
+	if len(values) != len(tests) {
+		t.Errorf("got the columns %v, want %d", values, len(tests))
+	}
+}
+
+func TestMarshalRoundTrip(t *testing.T) {
+	tests := []scalars{
+		{Key: "zero"},
+		{
+			Key: "min", Int: math.MinInt32, Int8: math.MinInt8, Int16: math.MinInt16, Int32: math.MinInt32,
+			Int64: math.MinInt64, Float32: -math.MaxFloat32, Float64: -math.MaxFloat64,
+		},
+		{
+			Key: "max", Int: math.MaxInt32, Int8: math.MaxInt8, Int16: math.MaxInt16, Int32: math.MaxInt32,
+			Int64: math.MaxInt64, Uint: math.MaxUint32, Uint8: math.MaxUint8, Uint16: math.MaxUint16,
+			Uint32: math.MaxUint32, Uint64: math.MaxUint64, Float32: math.MaxFloat32, Float64: math.MaxFloat64,
+		},
+		{
+			Key: "values", Str: "héllo", Bytes: []byte{0, 0xff}, Int8: -128, Int16: -300, Int32: -70000,
+			Float32: float32(math.Inf(-1)), Float64: math.SmallestNonzeroFloat64, Bool: true,
+			Time: time.Unix(1600000000, int64(123*time.Millisecond)),
+		},
+	}
+	for _, want := range tests {
+		m, err := goh.Marshal(&want)
+		if err != nil {
+			t.Fatalf("%s: %v", want.Key, err)
+		}
+
+		var got scalars
+		if err := goh.Unmarshal(rowOf(m, 1), &got); err != nil {
+			t.Fatalf("%s: %v", want.Key, err)
+		}
+		if !got.Time.Equal(want.Time) && !want.Time.IsZero() {
+			t.Fatalf("%s: got the time %v, want %v", want.Key, got.Time, want.Time)
+		}
+		got.Time, want.Time = time.Time{}, time.Time{}
+		if got.Bytes == nil {
+			got.Bytes = want.Bytes
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("%s: got %+v, want %+v", want.Key, got, want)
+		}
+	}
+}
+
+func TestUnmarshalSignExtension(t *testing.T) {
+	tests := []struct {
+		column string
+		value  []byte
+		want   int64
+	}{
+		{"cf:int8", []byte{0x80}, math.MinInt8},
+		{"cf:int8", []byte{0x7f}, math.MaxInt8},
+		{"cf:int16", []byte{0xff, 0x7f}, -129},
+		{"cf:int32", []byte{0x80, 0, 0, 0}, math.MinInt32},
+		{"cf:int32", []byte{0xff, 0xff, 0xff, 0xff}, -1},
+		{"cf:int", []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xfe}, -2},
+	}
+	for _, tt := range tests {
+		row := &proto.TRowResult_{Row: []byte("row"), Columns: map[string]*proto.TCell{
+			tt.column: {Value: tt.value},
+		}}
+		var got scalars
+		if err := goh.Unmarshal(row, &got); err != nil {
+			t.Fatalf("%s %x: %v", tt.column, tt.value, err)
+		}
+		n := map[string]int64{
+			"cf:int8":  int64(got.Int8),
+			"cf:int16": int64(got.Int16),
+			"cf:int32": int64(got.Int32),
+			"cf:int":   int64(got.Int),
+		}[tt.column]
+		if n != tt.want {
+			t.Errorf("%s %x: got %d, want %d", tt.column, tt.value, n, tt.want)
+		}
+	}
+}
+
+func TestUnmarshalWrongSize(t *testing.T) {
+	for _, column := range []string{"cf:int", "cf:uint", "cf:int32", "cf:float64", "cf:bool", "cf:time"} {
+		row := &proto.TRowResult_{Row: []byte("row"), Columns: map[string]*proto.TCell{
+			column: {Value: []byte{1, 2, 3}},
+		}}
+		var got scalars
+		if err := goh.Unmarshal(row, &got); err == nil {
+			t.Errorf("%s: 3 bytes decoded", column)
+		}
+	}
+}
+
+type options struct {
+	Id      []byte            `hbase:"rowkey"`
+	Tags    map[string]string `hbase:"cf:tags,json"`
+	Age     *int32            `hbase:"cf:age"`
+	Name    *string           `hbase:"cf:name"`
+	Count   int64             `hbase:"cf:count,omitempty"`
+	Note    string            `hbase:"cf:note,omitempty"`
+	Updated int64             `hbase:"cf:tags,timestamp"`
+	Seen    time.Time         `hbase:"cf:count,timestamp"`
+}
+
+func TestMarshalOptions(t *testing.T) {
+	age := int32(42)
+	tests := []struct {
+		name    string
+		in      options
+		columns []string
+	}{
+		{"empty", options{Id: []byte("r")}, []string{"cf:tags"}},
+		{"nil pointers and zero values are not written", options{Id: []byte("r"), Tags: map[string]string{"a": "b"}}, []string{"cf:tags"}},
+		{"all set", options{Id: []byte("r"), Tags: map[string]string{}, Age: &age, Count: 3, Note: "n"}, []string{"cf:age", "cf:count", "cf:note", "cf:tags"}},
+	}
+	for _, tt := range tests {
+		m, err := goh.Marshal(&tt.in)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		var got []string
+		for column := range columns(m) {
+			got = append(got, column)
+		}
+		if !sameSet(got, tt.columns) {
+			t.Fatalf("%s: got the columns %v, want %v", tt.name, got, tt.columns)
+		}
+	}
+
+	in := options{Id: []byte("r"), Tags: map[string]string{"a": "b"}, Age: &age, Count: 3}
+	m, err := goh.Marshal(&in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(columns(m)["cf:tags"]) != `{"a":"b"}` {
+		t.Fatalf("json column %s", columns(m)["cf:tags"])
+	}
+
+	var out options
+	if err := goh.Unmarshal(rowOf(m, 1600000000123), &out); err != nil {
+		t.Fatal(err)
+	}
+	if string(out.Id) != "r" || out.Tags["a"] != "b" || out.Age == nil || *out.Age != 42 || out.Name != nil || out.Count != 3 {
+		t.Fatalf("got %+v", out)
+	}
+	if out.Updated != 1600000000123 || !out.Seen.Equal(time.Unix(1600000000, int64(123*time.Millisecond))) {
+		t.Fatalf("timestamps %d and %v", out.Updated, out.Seen)
+	}
+}
+
+func sameSet(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	seen := make(map[string]bool, len(a))
+	for _, s := range a {
+		seen[s] = true
+	}
+	for _, s := range b {
+		if !seen[s] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestMarshalErrors(t *testing.T) {
+	var noKey struct {
+		Name string `hbase:"cf:name"`
+	}
+	if _, err := goh.Marshal(&noKey); err != goh.ErrNoRowKey {
+		t.Fatalf("got %v, want ErrNoRowKey", err)
+	}
+
+	var unsupported struct {
+		Id   string         `hbase:"rowkey"`
+		Tags map[string]int `hbase:"cf:tags"`
+	}
+	if _, err := goh.Marshal(&unsupported); err == nil {
+		t.Fatal("map without the json option marshalled")
+	}
+
+	var noFamily struct {
+		Id   string `hbase:"rowkey"`
+		Name string `hbase:"name"`
+	}
+	if _, err := goh.Marshal(&noFamily); err == nil {
+		t.Fatal("column without a family marshalled")
+	}
+
+	if _, err := goh.Marshal(noKey); err == nil {
+		t.Fatal("struct value marshalled")
+	}
+}
+
+func TestUnmarshalRows(t *testing.T) {
+	results := []*proto.TRowResult_{
+		{Row: []byte("a"), Columns: map[string]*proto.TCell{"cf:str": {Value: []byte("1")}}},
+		//GetRow with sorted columns
+		{Row: []byte("b"), SortedColumns: []*proto.TColumn{{ColumnName: []byte("cf:str"), Cell: &proto.TCell{Value: []byte("2")}}}},
+	}
+
+	var values []scalars
+	if err := goh.UnmarshalRows(results, &values); err != nil {
+		t.Fatal(err)
+	}
+	if len(values) != 2 || values[0].Key != "a" || values[0].Str != "1" || values[1].Key != "b" || values[1].Str != "2" {
+		t.Fatalf("got %+v", values)
+	}
+
+	pointers := []*scalars{{Key: "existing"}}
+	if err := goh.UnmarshalRows(results, &pointers); err != nil {
+		t.Fatal(err)
+	}
+	if len(pointers) != 3 || pointers[0].Key != "existing" || pointers[2].Str != "2" {
+		t.Fatalf("got %+v", pointers)
+	}
+
+	var notStructs []string
+	if err := goh.UnmarshalRows(results, &notStructs); err == nil {
+		t.Fatal("rows unmarshalled into strings")
+	}
+	if err := goh.UnmarshalRows(results, values); err == nil {
+		t.Fatal("rows unmarshalled into a slice value")
+	}
+}
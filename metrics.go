@@ -0,0 +1,228 @@
+package gogohbase
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// reasons of the evictions counted by Metrics
+const (
+	evictTimeout  = "timeout"      //idle for longer than idleTimeout
+	evictCheck    = "check_failed" //transport closed or checkAlive failed
+	evictOverflow = "overflow"     //over maxConn, or too many idle connections
+	evictLifetime = "lifetime"     //open for longer than maxLifetime
+	evictRecycled = "recycled"     //opened before ForceRecycle
+	evictRemoved  = "removed"      //the address was dropped by the resolver
+	evictError    = "error"        //closed after a transport error
+	evictClosed   = "closed"       //closed by Destroy or Shutdown
+	evictLeaked   = "leaked"       //reclaimed by the leak detector
+)
+
+// rpcObserver records one rpc of a client
+type rpcObserver func(method, table string, elapsed time.Duration, err error)
+
+/*
+MetricsOpts configures the collectors of NewMetrics
+*/
+type MetricsOpts struct {
+	Namespace   string
+	Subsystem   string
+	ConstLabels prometheus.Labels //tells apart the pools registered in the same registry
+	Buckets     []float64         //rpc latency buckets in seconds, prometheus.DefBuckets by default
+}
+
+/*
+Metrics is a prometheus.Collector of the connections and the rpcs of the pools
+created WithMetrics. Register it yourself:
+
+	metrics := goh.NewMetrics(goh.MetricsOpts{Namespace: "hbase"})
+	prometheus.MustRegister(metrics)
+	pool := goh.NewThriftPool(ctx, addr, 100, 60, 30*time.Second, dial, closeFunc, checkAlive, goh.WithMetrics(metrics))
+*/
+type Metrics struct {
+	lock  sync.RWMutex
+	pools []*ThriftPool
+
+	open    *prometheus.Desc
+	idle    *prometheus.Desc
+	waiting *prometheus.Desc
+
+	dials        prometheus.Counter
+	dialFailures prometheus.Counter
+	evictions    *prometheus.CounterVec
+	leaks        prometheus.Counter
+	rpcs         *prometheus.HistogramVec
+}
+
+/*
+NewMetrics creates the collectors, nothing is registered
+*/
+func NewMetrics(opts MetricsOpts) *Metrics {
+	name := func(name string) string {
+		return prometheus.BuildFQName(opts.Namespace, opts.Subsystem, name)
+	}
+
+	buckets := opts.Buckets
+	if len(buckets) == 0 {
+		buckets = prometheus.DefBuckets
+	}
+
+	return &Metrics{
+		open:    prometheus.NewDesc(name("pool_open_connections"), "Number of open connections, in use or idle.", nil, opts.ConstLabels),
+		idle:    prometheus.NewDesc(name("pool_idle_connections"), "Number of idle connections.", nil, opts.ConstLabels),
+		waiting: prometheus.NewDesc(name("pool_waiting"), "Number of callers waiting for a connection.", nil, opts.ConstLabels),
+		dials: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace:   opts.Namespace,
+			Subsystem:   opts.Subsystem,
+			Name:        "pool_dials_total",
+			Help:        "Total number of dials.",
+			ConstLabels: opts.ConstLabels,
+		}),
+		dialFailures: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace:   opts.Namespace,
+			Subsystem:   opts.Subsystem,
+			Name:        "pool_dial_failures_total",
+			Help:        "Total number of failed dials.",
+			ConstLabels: opts.ConstLabels,
+		}),
+		evictions: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   opts.Namespace,
+			Subsystem:   opts.Subsystem,
+			Name:        "pool_evictions_total",
+			Help:        "Total number of connections closed by the pool, by reason.",
+			ConstLabels: opts.ConstLabels,
+		}, []string{"reason"}),
+		leaks: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace:   opts.Namespace,
+			Subsystem:   opts.Subsystem,
+			Name:        "pool_leaked_connections_total",
+			Help:        "Total number of connections borrowed for longer than the leak threshold.",
+			ConstLabels: opts.ConstLabels,
+		}),
+		rpcs: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace:   opts.Namespace,
+			Subsystem:   opts.Subsystem,
+			Name:        "rpc_duration_seconds",
+			Help:        "Latency of the thrift rpcs, by method, table and outcome.",
+			ConstLabels: opts.ConstLabels,
+			Buckets:     buckets,
+		}, []string{"method", "table", "outcome"}),
+	}
+}
+
+/*
+WithMetrics records the connections and the rpcs of the pool in metrics,
+the pool leaves the gauges on Shutdown or Destroy
+*/
+func WithMetrics(metrics *Metrics) Option {
+	return func(p *ThriftPool) {
+		p.metrics = metrics
+		metrics.lock.Lock()
+		metrics.pools = append(metrics.pools, p)
+		metrics.lock.Unlock()
+	}
+}
+
+// remove stops collecting the gauges of p once it is shut down, pools are
+// replaced by new slices since Collect reads them outside of the lock
+func (m *Metrics) remove(p *ThriftPool) {
+	if m == nil {
+		return
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	pools := make([]*ThriftPool, 0, len(m.pools))
+	for _, pool := range m.pools {
+		if pool != p {
+			pools = append(pools, pool)
+		}
+	}
+	m.pools = pools
+}
+
+// Describe implements prometheus.Collector
+func (m *Metrics) Describe(ch chan<- *prometheus.Desc) {
+	ch <- m.open
+	ch <- m.idle
+	ch <- m.waiting
+	m.dials.Describe(ch)
+	m.dialFailures.Describe(ch)
+	m.evictions.Describe(ch)
+	m.leaks.Describe(ch)
+	m.rpcs.Describe(ch)
+}
+
+// Collect implements prometheus.Collector, the gauges add up all the pools
+func (m *Metrics) Collect(ch chan<- prometheus.Metric) {
+	m.lock.RLock()
+	pools := m.pools
+	m.lock.RUnlock()
+
+	var open, idle, waiting int
+	for _, p := range pools {
+		p.lock.RLock()
+		open += p.count
+		idle += p.idle.Len()
+		waiting += p.waiters.Len()
+		p.lock.RUnlock()
+	}
+
+	ch <- prometheus.MustNewConstMetric(m.open, prometheus.GaugeValue, float64(open))
+	ch <- prometheus.MustNewConstMetric(m.idle, prometheus.GaugeValue, float64(idle))
+	ch <- prometheus.MustNewConstMetric(m.waiting, prometheus.GaugeValue, float64(waiting))
+	m.dials.Collect(ch)
+	m.dialFailures.Collect(ch)
+	m.evictions.Collect(ch)
+	m.leaks.Collect(ch)
+	m.rpcs.Collect(ch)
+}
+
+// the recorders are no-ops on nil so that the pool can call them unconditionally
+
+func (m *Metrics) dial(err error) {
+	if m == nil {
+		return
+	}
+
+	m.dials.Inc()
+	if err != nil {
+		m.dialFailures.Inc()
+	}
+}
+
+func (m *Metrics) evict(reason string) {
+	if m == nil {
+		return
+	}
+	m.evictions.WithLabelValues(reason).Inc()
+}
+
+func (m *Metrics) leak() {
+	if m == nil {
+		return
+	}
+	m.leaks.Inc()
+}
+
+func (m *Metrics) observeRPC(method, table string, elapsed time.Duration, err error) {
+	m.rpcs.WithLabelValues(method, table, rpcOutcome(err)).Observe(elapsed.Seconds())
+}
+
+func rpcOutcome(err error) string {
+	switch {
+	case err == nil:
+		return "ok"
+	case errors.Is(err, context.DeadlineExceeded):
+		return "timeout"
+	case errors.Is(err, context.Canceled):
+		return "canceled"
+	case isTransportError(err):
+		return "transport_error"
+	}
+	return "error"
+}
@@ -0,0 +1,176 @@
+package gogohbase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	goh "github.com/blackbeans/gogobase"
+	"github.com/blackbeans/gogobase/hbasetest"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// gather returns the value of the metric name whose labels include labels: the
+// value of a gauge or a counter, the sample count of a histogram, -1 if it is missing
+func gather(t *testing.T, registry *prometheus.Registry, name string, labels map[string]string) float64 {
+	families, err := registry.Gather()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, family := range families {
+		if family.GetName() != name {
+			continue
+		}
+
+	metrics:
+		for _, metric := range family.GetMetric() {
+			for k, v := range labels {
+				found := false
+				for _, pair := range metric.GetLabel() {
+					if pair.GetName() == k && pair.GetValue() == v {
+						found = true
+					}
+				}
+				if !found {
+					continue metrics
+				}
+			}
+
+			switch {
+			case metric.GetGauge() != nil:
+				return metric.GetGauge().GetValue()
+			case metric.GetCounter() != nil:
+				return metric.GetCounter().GetValue()
+			case metric.GetHistogram() != nil:
+				return float64(metric.GetHistogram().GetSampleCount())
+			}
+		}
+	}
+	return -1
+}
+
+func TestMetricsCollector(t *testing.T) {
+	srv := hbasetest.NewServer()
+	defer srv.Close()
+	srv.Fake.MustCreateTable("t", "cf")
+
+	metrics := goh.NewMetrics(goh.MetricsOpts{Namespace: "hbase"})
+	registry := prometheus.NewPedanticRegistry()
+	registry.MustRegister(metrics)
+
+	pool := goh.NewPool(srv.Addr, goh.WithMetrics(metrics), goh.WithMaxOpen(2))
+	defer pool.Destroy()
+
+	//rpcs by method, table and outcome
+	if err := pool.Do(getTableNames); err != nil {
+		t.Fatal(err)
+	}
+	pool.Do(func(cli *goh.HClient) error {
+		_, err := cli.GetRow("missing", []byte("row"), nil)
+		return err
+	})
+	for _, tt := range []struct {
+		method, table, outcome string
+	}{
+		{"GetTableNames", "", "ok"},
+		{"GetRow", "missing", "error"},
+	} {
+		labels := map[string]string{"method": tt.method, "table": tt.table, "outcome": tt.outcome}
+		if got := gather(t, registry, "hbase_rpc_duration_seconds", labels); got != 1 {
+			t.Fatalf("%v: %v rpcs observed, want 1", labels, got)
+		}
+	}
+
+	//gauges
+	c1, err := pool.Get()
+	if err != nil {
+		t.Fatal(err)
+	}
+	c2, err := pool.Get()
+	if err != nil {
+		t.Fatal(err)
+	}
+	waited := make(chan error, 1)
+	go func() {
+		c, err := pool.GetContext(context.Background())
+		if err == nil {
+			pool.Put(c)
+		}
+		waited <- err
+	}()
+	for deadline := time.Now().Add(time.Second); pool.GetWaitingCount() != 1; time.Sleep(time.Millisecond) {
+		if time.Now().After(deadline) {
+			t.Fatal("Get did not wait")
+		}
+	}
+	for name, want := range map[string]float64{
+		"hbase_pool_open_connections": 2,
+		"hbase_pool_idle_connections": 0,
+		"hbase_pool_waiting":          1,
+		"hbase_pool_dials_total":      2,
+	} {
+		if got := gather(t, registry, name, nil); got != want {
+			t.Fatalf("%s: got %v, want %v", name, got, want)
+		}
+	}
+	pool.Put(c1)
+	if err := <-waited; err != nil {
+		t.Fatal(err)
+	}
+	if got := gather(t, registry, "hbase_pool_idle_connections", nil); got != 1 {
+		t.Fatalf("got %v idle connections, want 1", got)
+	}
+
+	//evictions by reason
+	pool.CloseErrConn(c2)
+	srv.CloseClientConnections()
+	err = pool.Do(getTableNames)
+	if !errors.Is(err, goh.ErrTransport) {
+		t.Fatalf("got %v, want the transport error of the closed connection", err)
+	}
+	if got := gather(t, registry, "hbase_pool_evictions_total", map[string]string{"reason": "error"}); got != 2 {
+		t.Fatalf("got %v evictions after errors, want 2", got)
+	}
+	if got := gather(t, registry, "hbase_rpc_duration_seconds", map[string]string{"outcome": "transport_error"}); got != 1 {
+		t.Fatalf("got %v rpcs with a transport error, want 1", got)
+	}
+}
+
+func TestMetricsForgetDestroyedPool(t *testing.T) {
+	srv := hbasetest.NewServer()
+	defer srv.Close()
+
+	metrics := goh.NewMetrics(goh.MetricsOpts{Namespace: "hbase"})
+	registry := prometheus.NewPedanticRegistry()
+	registry.MustRegister(metrics)
+
+	kept := goh.NewPool(srv.Addr, goh.WithMetrics(metrics))
+	defer kept.Destroy()
+	destroyed := goh.NewPool(srv.Addr, goh.WithMetrics(metrics))
+	if err := kept.Warmup(context.Background(), 1); err != nil {
+		t.Fatal(err)
+	}
+	if err := destroyed.Warmup(context.Background(), 2); err != nil {
+		t.Fatal(err)
+	}
+	borrowed, err := destroyed.Get()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := gather(t, registry, "hbase_pool_open_connections", nil); got != 3 {
+		t.Fatalf("got %v open connections, want 3", got)
+	}
+
+	//the borrowed connection of the destroyed pool is not reported any more
+	destroyed.Destroy()
+	if got := gather(t, registry, "hbase_pool_open_connections", nil); got != 1 {
+		t.Fatalf("got %v open connections, want the one of the pool left", got)
+	}
+
+	//its evictions are still counted
+	destroyed.Put(borrowed)
+	if got := gather(t, registry, "hbase_pool_evictions_total", map[string]string{"reason": "closed"}); got != 2 {
+		t.Fatalf("got %v evictions on close, want 2", got)
+	}
+}
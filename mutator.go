@@ -0,0 +1,335 @@
+package gogohbase
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/blackbeans/gogobase/proto"
+)
+
+// defaults of MutatorOpts
+const (
+	defaultMutatorMaxRows     = 1000
+	defaultMutatorMaxBytes    = 2 << 20
+	defaultMutatorLinger      = 100 * time.Millisecond
+	defaultMutatorConcurrency = 4
+)
+
+var ErrMutatorClosed = errors.New("BufferedMutator has been closed")
+
+/*
+MutatorOpts configures a BufferedMutator, the zero values take the defaults
+*/
+type MutatorOpts struct {
+	MaxRows     int               //rows of a table buffered before they are written, 1000 by default
+	MaxBytes    int               //bytes of row keys, columns and values of a table buffered before they are written, 2MB by default
+	Linger      time.Duration     //longest time a row is buffered, 100ms by default
+	Concurrency int               //MutateRows running at once, 4 by default
+	Timeout     time.Duration     //bounds each MutateRows, 0 means none
+	Attributes  map[string]string //attributes of every MutateRows
+	Retry       *RetryPolicy      //retries the failed MutateRows, nil means no retry
+
+	//OnError is called with the rows of each failed MutateRows, from the goroutine which wrote them
+	OnError func(table string, rows []*proto.BatchMutation, err error)
+}
+
+/*
+MutateError is the error of one MutateRows, none of its rows may be written
+*/
+type MutateError struct {
+	Table string
+	Rows  []*proto.BatchMutation
+	Err   error
+}
+
+func (e *MutateError) Error() string {
+	return fmt.Sprintf("MutateRows %s of %d rows: %v", e.Table, len(e.Rows), e.Err)
+}
+
+func (e *MutateError) Unwrap() error {
+	return e.Err
+}
+
+/*
+MutateErrors aggregates the failed MutateRows waited for by Flush
+*/
+type MutateErrors []*MutateError
+
+func (e MutateErrors) Error() string {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "%d MutateRows failed:", len(e))
+	for _, me := range e {
+		b.WriteString(" ")
+		b.WriteString(me.Error())
+		b.WriteString(";")
+	}
+	return b.String()
+}
+
+/*
+BufferedMutator buffers the mutations of many goroutines and writes them per table
+with MutateRows on pooled clients, once a table has MaxRows rows or MaxBytes bytes
+buffered or its oldest row has waited for Linger. At most Concurrency MutateRows
+run at once, Mutate blocks when they are all busy.
+
+Writes are asynchronous: the failed rows are passed to OnError and kept until the
+next Flush or Close returns them. The mutations must not be modified once given to
+the mutator.
+
+	mutator := goh.NewBufferedMutator(pool, goh.MutatorOpts{
+		OnError: func(table string, rows []*proto.BatchMutation, err error) {
+			log.Println("lost", len(rows), "rows of", table, err)
+		},
+	})
+	defer mutator.Close(context.Background())
+
+	err := mutator.MutateRow("table_name", []byte("row"), mutations)
+*/
+type BufferedMutator struct {
+	pool   *ThriftPool
+	opts   MutatorOpts
+	tokens chan struct{}
+
+	lock     sync.Mutex
+	buffers  map[string]*mutationBuffer
+	inflight map[*mutateJob]struct{} //已经分离出来还没写完的
+	failed   MutateErrors            //还没有被Flush返回的失败
+	closed   bool
+}
+
+// rows of a table waiting to be written
+type mutationBuffer struct {
+	table string
+	rows  []*proto.BatchMutation
+	size  int
+	timer *time.Timer
+}
+
+// one MutateRows, done is closed once it is written or failed
+type mutateJob struct {
+	table string
+	rows  []*proto.BatchMutation
+	done  chan struct{}
+}
+
+/*
+NewBufferedMutator returns a mutator writing with the clients of pool
+*/
+func NewBufferedMutator(pool *ThriftPool, opts MutatorOpts) *BufferedMutator {
+	if opts.MaxRows <= 0 {
+		opts.MaxRows = defaultMutatorMaxRows
+	}
+	if opts.MaxBytes <= 0 {
+		opts.MaxBytes = defaultMutatorMaxBytes
+	}
+	if opts.Linger <= 0 {
+		opts.Linger = defaultMutatorLinger
+	}
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = defaultMutatorConcurrency
+	}
+
+	return &BufferedMutator{
+		pool:     pool,
+		opts:     opts,
+		tokens:   make(chan struct{}, opts.Concurrency),
+		buffers:  make(map[string]*mutationBuffer),
+		inflight: make(map[*mutateJob]struct{}),
+	}
+}
+
+/*
+MutateRow buffers the mutations of row
+*/
+func (m *BufferedMutator) MutateRow(table string, row []byte, mutations []*proto.Mutation) error {
+	return m.Mutate(table, &proto.BatchMutation{Row: row, Mutations: mutations})
+}
+
+/*
+Mutate buffers the rows of table, it fails with ErrMutatorClosed after Close
+*/
+func (m *BufferedMutator) Mutate(table string, rows ...*proto.BatchMutation) error {
+	if len(rows) == 0 {
+		return nil
+	}
+
+	m.lock.Lock()
+	if m.closed {
+		m.lock.Unlock()
+		return ErrMutatorClosed
+	}
+
+	buf := m.buffers[table]
+	if buf == nil {
+		buf = &mutationBuffer{table: table}
+		buf.timer = time.AfterFunc(m.opts.Linger, func() { m.linger(buf) })
+		m.buffers[table] = buf
+	}
+
+	var jobs []*mutateJob
+	for _, row := range rows {
+		buf.rows = append(buf.rows, row)
+		buf.size += mutationSize(row)
+		if len(buf.rows) >= m.opts.MaxRows || buf.size >= m.opts.MaxBytes {
+			jobs = append(jobs, m.detach(buf))
+			buf = &mutationBuffer{table: table}
+			buf.timer = time.AfterFunc(m.opts.Linger, func() { m.linger(buf) })
+			m.buffers[table] = buf
+		}
+	}
+	//最后一行刚好写满时不留空的缓冲
+	if len(buf.rows) == 0 {
+		buf.timer.Stop()
+		delete(m.buffers, table)
+	}
+	m.lock.Unlock()
+
+	for _, job := range jobs {
+		m.start(job)
+	}
+	return nil
+}
+
+/*
+Flush writes the buffered rows and waits for them and for the MutateRows already
+running. It returns the error of ctx, or MutateErrors with every MutateRows failed
+since the previous Flush, including the ones written before this call.
+*/
+func (m *BufferedMutator) Flush(ctx context.Context) error {
+	m.lock.Lock()
+	jobs := make([]*mutateJob, 0, len(m.inflight)+len(m.buffers))
+	for job := range m.inflight {
+		jobs = append(jobs, job)
+	}
+	pending := make([]*mutateJob, 0, len(m.buffers))
+	for _, buf := range m.buffers {
+		pending = append(pending, m.detach(buf))
+	}
+	m.lock.Unlock()
+
+	for _, job := range pending {
+		go m.start(job)
+	}
+	jobs = append(jobs, pending...)
+
+	for _, job := range jobs {
+		select {
+		case <-job.done:
+		case <-ctx.Done():
+			//失败留给下一次Flush
+			return ctx.Err()
+		}
+	}
+
+	m.lock.Lock()
+	errs := m.failed
+	m.failed = nil
+	m.lock.Unlock()
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+/*
+Close rejects the new mutations and flushes the buffered ones
+*/
+func (m *BufferedMutator) Close(ctx context.Context) error {
+	m.lock.Lock()
+	m.closed = true
+	m.lock.Unlock()
+	return m.Flush(ctx)
+}
+
+// detach takes the rows out of the buffer of table into a job, the lock must be held
+func (m *BufferedMutator) detach(buf *mutationBuffer) *mutateJob {
+	buf.timer.Stop()
+	if m.buffers[buf.table] == buf {
+		delete(m.buffers, buf.table)
+	}
+
+	job := &mutateJob{
+		table: buf.table,
+		rows:  buf.rows,
+		done:  make(chan struct{}),
+	}
+	m.inflight[job] = struct{}{}
+	return job
+}
+
+// linger writes the buffer once its oldest row has waited for Linger
+func (m *BufferedMutator) linger(buf *mutationBuffer) {
+	m.lock.Lock()
+	if m.buffers[buf.table] != buf {
+		//已经被写满或者Flush分离了
+		m.lock.Unlock()
+		return
+	}
+	job := m.detach(buf)
+	m.lock.Unlock()
+
+	m.start(job)
+}
+
+// start waits for a free slot and writes the job in the background
+func (m *BufferedMutator) start(job *mutateJob) {
+	m.tokens <- struct{}{}
+	go func() {
+		defer func() {
+			<-m.tokens
+			m.lock.Lock()
+			delete(m.inflight, job)
+			m.lock.Unlock()
+			close(job.done)
+		}()
+
+		m.write(job)
+	}()
+}
+
+func (m *BufferedMutator) write(job *mutateJob) {
+	ctx := context.Background()
+	if m.opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, m.opts.Timeout)
+		defer cancel()
+	}
+
+	mutateRows := func(ctx context.Context, cli *HClient) error {
+		return cli.MutateRowsCtx(ctx, job.table, job.rows, m.opts.Attributes)
+	}
+	var err error
+	if m.opts.Retry != nil {
+		err = m.pool.DoRetry(ctx, m.opts.Retry, mutateRows)
+	} else {
+		err = m.pool.DoContext(ctx, func(cli *HClient) error { return mutateRows(ctx, cli) })
+	}
+	if err == nil {
+		return
+	}
+
+	m.lock.Lock()
+	m.failed = append(m.failed, &MutateError{Table: job.table, Rows: job.rows, Err: err})
+	m.lock.Unlock()
+
+	m.pool.logger.Warn("buffered mutator write failed", "table", job.table, "rows", len(job.rows), "err", err)
+	if m.opts.OnError != nil {
+		m.opts.OnError(job.table, job.rows, err)
+	}
+}
+
+// mutationSize is the size of the row key, the columns and the values of row
+func mutationSize(row *proto.BatchMutation) int {
+	n := len(row.Row)
+	for _, mutation := range row.Mutations {
+		if mutation != nil {
+			n += len(mutation.Column) + len(mutation.Value)
+		}
+	}
+	return n
+}
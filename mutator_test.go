@@ -0,0 +1,42 @@
+package gogohbase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	goh "github.com/blackbeans/gogobase"
+	"github.com/blackbeans/gogobase/hbasetest"
+	"github.com/blackbeans/gogobase/proto"
+)
+
+func TestMutatorFlushReportsEarlierFailures(t *testing.T) {
+	srv := hbasetest.NewServer()
+	defer srv.Close()
+
+	pool := goh.NewPool(srv.Addr)
+	defer pool.Destroy()
+
+	mutator := goh.NewBufferedMutator(pool, goh.MutatorOpts{Linger: 5 * time.Millisecond})
+	mutation := []*proto.Mutation{{Column: proto.Text("cf:a"), Value: proto.Text("v")}}
+	if err := mutator.MutateRow("missing", []byte("row"), mutation); err != nil {
+		t.Fatal(err)
+	}
+
+	//the linger write fails before Flush is called
+	time.Sleep(100 * time.Millisecond)
+
+	err := mutator.Flush(context.Background())
+	var errs goh.MutateErrors
+	if !errors.As(err, &errs) || len(errs) != 1 || len(errs[0].Rows) != 1 || errs[0].Table != "missing" {
+		t.Fatalf("Flush after a failed linger write: %v", err)
+	}
+	if !errors.Is(errs[0], goh.ErrTableNotFound) {
+		t.Fatalf("got %v, want ErrTableNotFound", errs[0])
+	}
+
+	if err := mutator.Close(context.Background()); err != nil {
+		t.Fatalf("the failure was reported twice: %v", err)
+	}
+}
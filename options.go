@@ -0,0 +1,359 @@
+package gogohbase
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// defaults of NewPool
+const (
+	defaultMaxOpen       = 20
+	defaultIdleTimeout   = 60 * time.Second
+	defaultCheckInterval = 30 * time.Second
+	defaultDialTimeout   = 5 * time.Second
+	defaultJitter        = 0.1
+)
+
+/*
+Option configures a ThriftPool
+*/
+type Option func(p *ThriftPool)
+
+/*
+dialConfig is used by the default Dial of NewPool
+*/
+type dialConfig struct {
+	protocol    int
+	framed      bool
+	http        bool          //addresses are urls of the http thrift server
+	thrift2     bool          //dial HClient2 instead of HClient
+	dialTimeout time.Duration //connect timeout of tcp clients
+	timeout     time.Duration //socket read/write timeout of tcp clients, 0 means none
+}
+
+/*
+NewPool returns a pool of binary, unframed tcp clients of the thrift gateway addr,
+the options change the defaults:
+
+	pool := goh.NewPool("127.0.0.1:9090",
+		goh.WithMaxOpen(100),
+		goh.WithIdleTimeout(5*time.Minute),
+		goh.WithProtocol(goh.TCompactProtocol),
+		goh.WithFramed())
+	defer pool.Destroy()
+*/
+func NewPool(addr string, opts ...Option) *ThriftPool {
+	return newPool([]string{addr}, opts)
+}
+
+func newPool(addrs []string, opts []Option) *ThriftPool {
+	p := &ThriftPool{
+		ctx:           context.Background(),
+		balancer:      newBalancer(addrs),
+		lock:          &sync.RWMutex{},
+		maxConn:       defaultMaxOpen,
+		idleTimeout:   defaultIdleTimeout,
+		checkInterval: defaultCheckInterval,
+		jitter:        defaultJitter,
+		probeTimeout:  defaultProbeTimeout,
+		trim:          TrimIdleRatio(4, 4),
+		logger:        nopLogger{},
+		stats:         &poolStats{},
+		done:          make(chan struct{}),
+		drained:       make(chan struct{}),
+		dialer: dialConfig{
+			protocol:    TBinaryProtocol,
+			dialTimeout: defaultDialTimeout,
+		},
+	}
+	p.Dial = p.dialDefault
+	p.Close = closeDefault
+	p.balancer.onChange = p.breakerChanged
+
+	for _, opt := range opts {
+		opt(p)
+	}
+	p.resolve()
+
+	go p.ClearConn()
+	p.warmup(p.minIdle)
+	return p
+}
+
+/*
+WithContext stops the health checks of the pool when ctx is done
+*/
+func WithContext(ctx context.Context) Option {
+	return func(p *ThriftPool) {
+		p.ctx = ctx
+	}
+}
+
+/*
+WithAddrs adds thrift gateways to the address of NewPool
+*/
+func WithAddrs(addrs ...string) Option {
+	return func(p *ThriftPool) {
+		p.balancer.update(append(p.balancer.addrList(), addrs...))
+	}
+}
+
+/*
+WithResolver replaces the addresses by the ones of resolver, refreshed on every health check.
+The address of NewPool is kept until the resolver returns some.
+*/
+func WithResolver(resolver Resolver) Option {
+	return func(p *ThriftPool) {
+		p.resolver = resolver
+	}
+}
+
+/*
+WithBalance sets how the addresses are picked: BalanceRoundRobin (default) or BalanceLeastConn
+*/
+func WithBalance(policy int) Option {
+	return func(p *ThriftPool) {
+		p.SetBalance(policy)
+	}
+}
+
+/*
+WithMaxOpen limits the connections, in use or idle
+*/
+func WithMaxOpen(n int) Option {
+	return func(p *ThriftPool) {
+		p.maxConn = n
+	}
+}
+
+/*
+WithMaxIdle limits the idle connections, 0 means no limit
+*/
+func WithMaxIdle(n int) Option {
+	return func(p *ThriftPool) {
+		p.maxIdle = n
+	}
+}
+
+/*
+WithMinIdle keeps at least n idle connections: they are dialed in the background
+when the pool is created and after the health checks evict some
+*/
+func WithMinIdle(n int) Option {
+	return func(p *ThriftPool) {
+		p.minIdle = n
+	}
+}
+
+/*
+TrimFunc tells whether the health check closes one more idle connection, given
+the open and the idle connections
+*/
+type TrimFunc func(open, idle int) bool
+
+/*
+TrimIdleRatio closes the idle connections while there are more than max of them
+and they are at least 1/ratio of the open ones. TrimIdleRatio(4, 4) is the default.
+*/
+func TrimIdleRatio(ratio, max int) TrimFunc {
+	return func(open, idle int) bool {
+		return open <= idle*ratio && idle > max
+	}
+}
+
+/*
+WithIdleTrim replaces the rule closing the surplus idle connections on every
+health check, nil keeps them until they time out. MinIdle is always kept.
+*/
+func WithIdleTrim(trim TrimFunc) Option {
+	return func(p *ThriftPool) {
+		p.trim = trim
+	}
+}
+
+/*
+WithIdleTimeout closes the connections idle for longer than d
+*/
+func WithIdleTimeout(d time.Duration) Option {
+	return func(p *ThriftPool) {
+		p.idleTimeout = d
+	}
+}
+
+/*
+WithMaxLifetime closes the connections opened for longer than d, so that the
+traffic moves to new gateways after a rolling restart. Each connection gets a
+lifetime shortened by up to 10% (see WithMaxLifetimeJitter) to spread the
+reconnects. 0 means no limit.
+*/
+func WithMaxLifetime(d time.Duration) Option {
+	return func(p *ThriftPool) {
+		p.maxLifetime = d
+	}
+}
+
+/*
+WithMaxLifetimeJitter shortens the lifetime of each connection by a random
+fraction of the max lifetime, up to jitter (0 to 1)
+*/
+func WithMaxLifetimeJitter(jitter float64) Option {
+	return func(p *ThriftPool) {
+		if jitter < 0 {
+			jitter = 0
+		} else if jitter > 1 {
+			jitter = 1
+		}
+		p.jitter = jitter
+	}
+}
+
+/*
+WithHealthCheckInterval sets how often the idle connections are checked
+*/
+func WithHealthCheckInterval(d time.Duration) Option {
+	return func(p *ThriftPool) {
+		if d > 0 {
+			p.checkInterval = d
+		}
+	}
+}
+
+/*
+WithCheckAlive checks the idle HClients with fn, only the transport is checked by default
+*/
+func WithCheckAlive(fn func(cli *HClient) bool) Option {
+	return func(p *ThriftPool) {
+		p.checkAlive = fn
+	}
+}
+
+/*
+WithDial replaces the default Dial, the dial options are then ignored
+*/
+func WithDial(dial Dial) Option {
+	return func(p *ThriftPool) {
+		if dial != nil {
+			p.Dial = dial
+		}
+	}
+}
+
+/*
+WithClose is called to close the connections instead of closing their transport
+*/
+func WithClose(closeFunc ClientClose) Option {
+	return func(p *ThriftPool) {
+		if closeFunc != nil {
+			p.Close = closeFunc
+		}
+	}
+}
+
+/*
+WithDialTimeout sets the connect timeout of the default Dial
+*/
+func WithDialTimeout(d time.Duration) Option {
+	return func(p *ThriftPool) {
+		p.dialer.dialTimeout = d
+	}
+}
+
+/*
+WithTimeout sets the socket read/write timeout of the clients of the default Dial
+*/
+func WithTimeout(d time.Duration) Option {
+	return func(p *ThriftPool) {
+		p.dialer.timeout = d
+	}
+}
+
+/*
+WithProtocol sets the thrift protocol of the default Dial: TBinaryProtocol (default),
+TCompactProtocol or TJSONProtocol
+*/
+func WithProtocol(protocol int) Option {
+	return func(p *ThriftPool) {
+		p.dialer.protocol = protocol
+	}
+}
+
+/*
+WithFramed makes the default Dial use the framed transport
+*/
+func WithFramed() Option {
+	return func(p *ThriftPool) {
+		p.dialer.framed = true
+	}
+}
+
+/*
+WithHTTP makes the default Dial use the http transport, the addresses are urls.
+Each request is bounded by WithTimeout and by the ctx of the rpc.
+*/
+func WithHTTP() Option {
+	return func(p *ThriftPool) {
+		p.dialer.http = true
+	}
+}
+
+/*
+WithThrift2 makes the default Dial return thrift2 clients, use Do2
+*/
+func WithThrift2() Option {
+	return func(p *ThriftPool) {
+		p.dialer.thrift2 = true
+	}
+}
+
+// dialDefault opens a client of addr as configured by the options
+func (p *ThriftPool) dialDefault(addr string) (*IdleClient, error) {
+	cfg := p.dialer
+
+	var conn *thriftConn
+	client := &IdleClient{}
+	switch {
+	case cfg.thrift2 && cfg.http:
+		hclient, err := NewHttpClient2(addr, cfg.protocol)
+		if err != nil {
+			return nil, err
+		}
+		client.Client2, conn = hclient, &hclient.thriftConn
+	case cfg.thrift2:
+		hclient, err := NewTcpClient2(addr, cfg.protocol, cfg.framed)
+		if err != nil {
+			return nil, err
+		}
+		client.Client2, conn = hclient, &hclient.thriftConn
+	case cfg.http:
+		hclient, err := NewHttpClient(addr, cfg.protocol)
+		if err != nil {
+			return nil, err
+		}
+		client.Client, conn = hclient, &hclient.thriftConn
+	default:
+		hclient, err := NewTcpClient(addr, cfg.protocol, cfg.framed)
+		if err != nil {
+			return nil, err
+		}
+		client.Client, conn = hclient, &hclient.thriftConn
+	}
+
+	//TSocket uses its timeout to connect as well
+	conn.SetTimeout(cfg.dialTimeout)
+	if err := conn.Open(); err != nil {
+		return nil, err
+	}
+	conn.SetTimeout(cfg.timeout)
+
+	client.Socket = conn.Trans
+	return client, nil
+}
+
+// closeDefault closes the transport of the client
+func closeDefault(c *IdleClient) error {
+	if conn := c.conn(); conn != nil {
+		return conn.Close()
+	}
+	return nil
+}
@@ -0,0 +1,173 @@
+package gogohbase
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/blackbeans/gogobase/proto"
+)
+
+/*
+RegionScanError is the error which stopped the scan of one region
+*/
+type RegionScanError struct {
+	Region *TRegionInfo
+	Err    error
+}
+
+func (e *RegionScanError) Error() string {
+	return fmt.Sprintf("region %s [%q,%q): %v", e.Region.Name, e.Region.StartKey, e.Region.EndKey, e.Err)
+}
+
+func (e *RegionScanError) Unwrap() error {
+	return e.Err
+}
+
+/*
+ParallelScanError aggregates the errors of all failed regions of a ParallelScan
+*/
+type ParallelScanError []*RegionScanError
+
+func (e ParallelScanError) Error() string {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "%d regions failed:", len(e))
+	for _, re := range e {
+		b.WriteString(" ")
+		b.WriteString(re.Error())
+		b.WriteString(";")
+	}
+	return b.String()
+}
+
+/*
+ParallelScan scans the table with one scanner per region, running at most
+concurrency scanners at once on pooled clients. Each region scans the
+intersection of the region's key range with [scan.StartRow, scan.StopRow).
+
+handler is called from several goroutines at once, but the rows of one
+region are delivered in order by a single goroutine. When handler or a
+scanner fails, the remaining regions are cancelled and the failures are
+returned as a ParallelScanError. When ctx ends before every region is started,
+ParallelScan returns the error of ctx. Reversed scans are not supported.
+*/
+func (p *ThriftPool) ParallelScan(ctx context.Context, tableName string, scan *TScan, attributes map[string]string,
+	concurrency int, handler func(region *TRegionInfo, row *proto.TRowResult_) error) error {
+	if scan == nil {
+		scan = &TScan{}
+	}
+
+	if scan.Reversed != nil && *scan.Reversed {
+		return newHbaseError(&proto.IllegalArgument{Message: "ParallelScan: reversed scans are not supported"}, nil)
+	}
+
+	var regions []*TRegionInfo
+	err := p.DoContext(ctx, func(cli *HClient) (e error) {
+		regions, e = cli.GetTableRegionsCtx(ctx, tableName)
+		return
+	})
+	if err != nil {
+		return err
+	}
+
+	if concurrency <= 0 {
+		concurrency = len(regions)
+	}
+
+	parent := ctx
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		skipped bool
+		wg      sync.WaitGroup
+		lock    sync.Mutex
+		errs    ParallelScanError
+		tokens  = make(chan struct{}, concurrency)
+	)
+
+	for _, region := range regions {
+		regionScan, ok := intersectRegion(scan, region)
+		if !ok {
+			continue
+		}
+
+		select {
+		case tokens <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			skipped = true
+			break
+		}
+
+		wg.Add(1)
+		go func(region *TRegionInfo, regionScan *TScan) {
+			defer func() {
+				<-tokens
+				wg.Done()
+			}()
+
+			if err := p.scanRegion(ctx, tableName, regionScan, attributes, region, handler); err != nil {
+				lock.Lock()
+				//被其他region的失败取消的不计入
+				if len(errs) == 0 || ctx.Err() == nil {
+					errs = append(errs, &RegionScanError{Region: region, Err: err})
+				}
+				lock.Unlock()
+				cancel()
+			}
+		}(region, regionScan)
+	}
+	wg.Wait()
+
+	//调用方取消了,剩下的region没有扫描
+	if err := parent.Err(); err != nil && skipped {
+		return err
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func (p *ThriftPool) scanRegion(ctx context.Context, tableName string, scan *TScan, attributes map[string]string,
+	region *TRegionInfo, handler func(region *TRegionInfo, row *proto.TRowResult_) error) error {
+	scanner, err := p.Scan(ctx, tableName, scan, attributes)
+	if err != nil {
+		return err
+	}
+	defer scanner.Close()
+
+	for scanner.Next() {
+		if err := handler(region, scanner.Row()); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
+
+/*
+intersectRegion narrows scan to the key range of the region, "" is unbounded
+*/
+func intersectRegion(scan *TScan, region *TRegionInfo) (*TScan, bool) {
+	start := string(scan.StartRow)
+	if region.StartKey > start {
+		start = region.StartKey
+	}
+
+	stop := string(scan.StopRow)
+	if region.EndKey != "" && (stop == "" || region.EndKey < stop) {
+		stop = region.EndKey
+	}
+
+	if stop != "" && start >= stop {
+		return nil, false
+	}
+
+	regionScan := *scan
+	regionScan.StartRow = []byte(start)
+	regionScan.StopRow = []byte(stop)
+	return &regionScan, true
+}
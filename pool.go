@@ -4,12 +4,13 @@ import (
 	"container/list"
 	"context"
 	"errors"
+	"fmt"
+	"math/rand"
 	"net"
 	"sync"
+	"sync/atomic"
 	"time"
 
-	"github.com/blackbeans/log4go"
-
 	"git.apache.org/thrift.git/lib/go/thrift"
 )
 
@@ -27,29 +28,63 @@ type ThriftPool struct {
 	idleTimeout   time.Duration
 	checkInterval time.Duration
 
-	maxConn int
-	count   int
-	addr    string
-	closed  bool
+	//等待链接的调用方,FIFO
+	waiters      list.List
+	waitCount    int64
+	waitDuration time.Duration
+
+	maxConn      int
+	maxIdle      int
+	minIdle      int
+	trim         TrimFunc //为nil时不清理过多的空闲链接
+	probe        Probe
+	probeTimeout time.Duration
+	warming      bool //后台正在补充空闲链接
+	maxLifetime  time.Duration
+	jitter       float64   //maxLifetime随机缩短的最大比例,避免同时重连
+	recycled     time.Time //ForceRecycle的时间,之前打开的链接都需要关闭
+	count        int
+	balancer     *balancer
+	resolver     Resolver
+	closed       bool
+	done         chan struct{} //关闭时close,停止ClearConn
+	drained      chan struct{} //关闭后借出的链接全部归还时close
+	checking     int           //正在健康检查的链接数
+	sweeps       int           //健康检查的轮次
+	stats        *poolStats
+
+	//泄漏检测,borrowed为nil时关闭
+	leakThreshold time.Duration
+	leakReclaim   time.Duration
+	borrowed      map[*IdleClient]*borrow
+
+	dialer          dialConfig
+	metrics         *Metrics
+	logger          Logger
+	onBreakerChange func(addr string, from, to BreakerState)
+}
+
+//等待者拿到的结果:归还的链接、新建链接的名额或者错误
+type waitResult struct {
+	c    *IdleClient
+	dial bool
+	err  error
 }
 
 type IdleClient struct {
 	Socket     thrift.TTransport
 	Client     *HClient
+	Client2    *HClient2 //thrift2的链接,与Client二选一
+	addr       string
 	createtime time.Time
+	expiretime time.Time //超过maxLifetime的时间,零值表示不限
+	reclaimed  bool      //被泄漏检测回收了
 }
 
 type idleConn struct {
-	c *IdleClient
-	t time.Time
-}
-
-//是否有效的
-func (c *idleConn) Check(duration time.Duration) bool {
-	if nowFunc().After(c.t.Add(duration)) || !c.c.Check() {
-		return false
-	}
-	return true
+	c      *IdleClient
+	t      time.Time
+	probed int //最近一次检查它的轮次
 }
 
 var nowFunc = time.Now
@@ -60,8 +95,25 @@ var (
 	ErrInvalidConn      = errors.New("Connection was broken")
 	ErrPoolClosed       = errors.New("Pool has been closed")
 	ErrSocketDisconnect = errors.New("Socket Disconnect")
+	ErrNotThrift2       = errors.New("Connection is not a thrift2 client")
 )
 
+//Shutdown超时时仍未归还的链接
+type LeakError struct {
+	Leaked int            //借出未归还的链接数
+	Conns  []BorrowedConn //开启泄漏检测时未归还的链接
+	Err    error          //ctx的错误
+}
+
+func (e *LeakError) Error() string {
+	return fmt.Sprintf("%d connections were not returned to the pool: %v", e.Leaked, e.Err)
+}
+
+func (e *LeakError) Unwrap() error {
+	return e.Err
+}
+
+//同NewPool,保留原有的参数,opts可以覆盖这些参数
 func NewThriftPool(
 	ctx context.Context,
 	addr string,
@@ -69,79 +121,330 @@ func NewThriftPool(
 	checkInterval time.Duration,
 	dial Dial,
 	closeFunc ClientClose,
-	checkAlive func(cli *HClient) bool) *ThriftPool {
-
-	if checkInterval <= 0 {
-		checkInterval = 30 * time.Second
-	}
+	checkAlive func(cli *HClient) bool,
+	opts ...Option) *ThriftPool {
+	return newThriftPool(ctx, []string{addr}, nil, maxConn, idleTimeout, checkInterval, dial, closeFunc, checkAlive, opts)
+}
 
-	thriftPool := &ThriftPool{
-		ctx:           ctx,
-		Dial:          dial,
-		Close:         closeFunc,
-		addr:          addr,
-		lock:          &sync.RWMutex{},
-		maxConn:       maxConn,
-		idleTimeout:   time.Duration(idleTimeout) * time.Second,
-		closed:        false,
-		count:         0,
-		checkInterval: checkInterval,
-		checkAlive:    checkAlive,
-	}
+//多个thrift网关地址的链接池,默认轮询Dial,连续失败的地址熔断并按指数退避重新探测
+func NewMultiThriftPool(
+	ctx context.Context,
+	addrs []string,
+	maxConn, idleTimeout int,
+	checkInterval time.Duration,
+	dial Dial,
+	closeFunc ClientClose,
+	checkAlive func(cli *HClient) bool,
+	opts ...Option) *ThriftPool {
+	return newThriftPool(ctx, addrs, nil, maxConn, idleTimeout, checkInterval, dial, closeFunc, checkAlive, opts)
+}
 
-	go thriftPool.ClearConn()
+//同NewMultiThriftPool,地址列表由resolver提供,每个checkInterval刷新一次
+func NewResolverThriftPool(
+	ctx context.Context,
+	resolver Resolver,
+	maxConn, idleTimeout int,
+	checkInterval time.Duration,
+	dial Dial,
+	closeFunc ClientClose,
+	checkAlive func(cli *HClient) bool,
+	opts ...Option) *ThriftPool {
+	return newThriftPool(ctx, nil, resolver, maxConn, idleTimeout, checkInterval, dial, closeFunc, checkAlive, opts)
+}
 
-	return thriftPool
+func newThriftPool(
+	ctx context.Context,
+	addrs []string,
+	resolver Resolver,
+	maxConn, idleTimeout int,
+	checkInterval time.Duration,
+	dial Dial,
+	closeFunc ClientClose,
+	checkAlive func(cli *HClient) bool,
+	opts []Option) *ThriftPool {
+
+	return newPool(addrs, append([]Option{
+		WithContext(ctx),
+		WithResolver(resolver),
+		WithMaxOpen(maxConn),
+		WithIdleTimeout(time.Duration(idleTimeout) * time.Second),
+		WithHealthCheckInterval(checkInterval),
+		WithDial(dial),
+		WithClose(closeFunc),
+		WithCheckAlive(checkAlive),
+	}, opts...))
 }
 
+//获取链接,没有空闲链接并且已经达到最大链接数时直接返回ErrOverMax
 func (p *ThriftPool) Get() (*IdleClient, error) {
-	p.lock.Lock()
-	if p.closed {
-		p.lock.Unlock()
-		return nil, ErrPoolClosed
+	return p.get(nil)
+}
+
+//获取链接,达到最大链接数时排队等待其他调用方归还链接,直到ctx结束
+func (p *ThriftPool) GetContext(ctx context.Context) (*IdleClient, error) {
+	return p.get(ctx)
+}
+
+//获取链接,最多等待timeout
+func (p *ThriftPool) GetWithTimeout(timeout time.Duration) (*IdleClient, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	return p.get(ctx)
+}
+
+func (p *ThriftPool) get(ctx context.Context) (*IdleClient, error) {
+	client, err := p.take(ctx)
+	if err == nil {
+		atomic.AddInt64(&p.stats.gets, 1)
+		p.borrow(client)
 	}
+	return client, err
+}
 
-	if p.idle.Len() == 0 && p.count >= p.maxConn {
+func (p *ThriftPool) take(ctx context.Context) (*IdleClient, error) {
+	p.lock.Lock()
+	for {
+		if p.closed {
+			p.lock.Unlock()
+			return nil, ErrPoolClosed
+		}
+
+		//优先寻找空闲的链接
+		for ele := p.idle.Front(); nil != ele; ele = p.idle.Front() {
+			idle := p.idle.Remove(ele).(*idleConn)
+
+			if reason := p.expired(idle); reason != "" {
+				if p.count > 0 {
+					p.count -= 1
+				}
+				//回收
+				p.evict(reason)
+				p.closeClient(idle.c)
+				idle.c = nil
+				idle = nil
+			} else {
+				p.lock.Unlock()
+				//检查是否真正存活
+				return idle.c, nil
+			}
+		}
+
+		if p.count < p.maxConn {
+			break
+		}
+		if ctx != nil {
+			return p.wait(ctx)
+		}
 		p.lock.Unlock()
 		return nil, ErrOverMax
 	}
 
-	//优先寻找空闲的链接
-	for ele := p.idle.Front(); nil != ele; ele = p.idle.Front() {
-		idle := p.idle.Remove(ele).(*idleConn)
+	//没有找到对应的存活链接，那么久直接新建一个
+	p.count += 1
+	p.lock.Unlock()
 
-		if !idle.Check(p.idleTimeout) {
-			if p.count > 0 {
-				p.count -= 1
+	return p.dial()
+}
+
+//排队等待归还的链接或者新建链接的名额,调用前需要持有锁
+func (p *ThriftPool) wait(ctx context.Context) (*IdleClient, error) {
+	req := make(chan waitResult, 1)
+	ele := p.waiters.PushBack(req)
+	p.lock.Unlock()
+
+	start := nowFunc()
+	var ret waitResult
+	select {
+	case ret = <-req:
+		p.addWait(start)
+	case <-ctx.Done():
+		p.lock.Lock()
+		p.waiters.Remove(ele)
+		p.lock.Unlock()
+		p.addWait(start)
+
+		//取消的同时已经分配到了,归还回去
+		select {
+		case ret = <-req:
+			if ret.c != nil {
+				p.put(ret.c)
+			} else if ret.dial {
+				p.lock.Lock()
+				p.release()
+				p.lock.Unlock()
 			}
-			//回收
-			p.Close(idle.c)
-			idle.c = nil
-			idle = nil
-		} else {
-			p.lock.Unlock()
-			//检查是否真正存活
-			return idle.c, nil
+		default:
 		}
+		return nil, ctx.Err()
 	}
 
-	//没有找到对应的存活链接，那么久直接新建一个
-	dial := p.Dial
-	p.count += 1
+	if ret.err != nil {
+		return nil, ret.err
+	}
+
+	if ret.c != nil {
+		return ret.c, nil
+	}
+	return p.dial()
+}
+
+func (p *ThriftPool) addWait(start time.Time) {
+	p.lock.Lock()
+	p.waitCount += 1
+	p.waitDuration += nowFunc().Sub(start)
 	p.lock.Unlock()
+}
 
-	client, err := dial(p.addr)
+//新建链接,调用前已经占用了链接名额
+func (p *ThriftPool) dial() (*IdleClient, error) {
+	addr, err := p.balancer.pick()
 	if err != nil {
 		p.lock.Lock()
-		if p.count > 0 {
-			p.count -= 1
-		}
+		p.release()
 		p.lock.Unlock()
 		return nil, err
 	}
+
+	client, err := p.Dial(addr)
+	p.dialed(err)
+	if err != nil {
+		p.logger.Warn("thrift pool dial failed", "addr", addr, "err", err)
+		p.balancer.dialFailed(addr)
+		p.lock.Lock()
+		p.release()
+		p.lock.Unlock()
+		return nil, err
+	}
+	p.balancer.succeed(addr)
+	client.addr = addr
 	client.createtime = nowFunc()
+	if p.maxLifetime > 0 {
+		lifetime := p.maxLifetime - time.Duration(rand.Float64()*p.jitter*float64(p.maxLifetime))
+		client.expiretime = client.createtime.Add(lifetime)
+	}
+	if conn := client.conn(); conn != nil && p.metrics != nil {
+		conn.observe = p.metrics.observeRPC
+	}
 	return client, nil
+}
+
+//空闲链接需要回收的原因,仍然有效时返回""
+func (p *ThriftPool) expired(idle *idleConn) string {
+	if nowFunc().After(idle.t.Add(p.idleTimeout)) {
+		return evictTimeout
+	}
+	if reason := p.aged(idle.c); reason != "" {
+		return reason
+	}
+	if !idle.c.Check() {
+		return evictCheck
+	}
+	if p.balancer.removed(idle.c.addr) {
+		return evictRemoved
+	}
+	return ""
+}
 
+//链接超过了maxLifetime或者在ForceRecycle之前打开时返回回收的原因,调用前需要持有锁
+func (p *ThriftPool) aged(client *IdleClient) string {
+	if client.createtime.Before(p.recycled) {
+		return evictRecycled
+	}
+	if !client.expiretime.IsZero() && !nowFunc().Before(client.expiretime) {
+		return evictLifetime
+	}
+	return ""
+}
+
+//新建链接直到空闲链接数达到n(不超过maxIdle),链接数达到上限时提前结束,最多Dial n次.
+//返回Dial失败的错误或者ctx的错误
+func (p *ThriftPool) Warmup(ctx context.Context, n int) error {
+	//归还时被关闭的链接不会计入空闲链接,限制Dial的次数避免一直Dial
+	for dials := 0; dials < n; dials++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		p.lock.Lock()
+		if p.closed {
+			p.lock.Unlock()
+			return ErrPoolClosed
+		}
+		if p.idle.Len() >= p.idleTarget(n) || p.count >= p.maxConn {
+			p.lock.Unlock()
+			return nil
+		}
+		p.count += 1
+		p.lock.Unlock()
+
+		client, err := p.dial()
+		if err != nil {
+			return err
+		}
+		p.put(client)
+	}
+	return nil
+}
+
+//空闲链接超过maxIdle时put会关闭,补充的数量不超过maxIdle,调用前需要持有锁
+func (p *ThriftPool) idleTarget(n int) int {
+	if p.maxIdle > 0 && n > p.maxIdle {
+		return p.maxIdle
+	}
+	return n
+}
+
+//后台补充空闲链接到n,同一时间只有一个在补充
+func (p *ThriftPool) warmup(n int) {
+	p.lock.Lock()
+	if p.warming || p.closed || p.idle.Len() >= p.idleTarget(n) {
+		p.lock.Unlock()
+		return
+	}
+	p.warming = true
+	p.lock.Unlock()
+
+	go func() {
+		if err := p.Warmup(p.ctx, n); err != nil && err != ErrPoolClosed && !errors.Is(err, ErrBreakerOpen) {
+			p.logger.Warn("thrift pool warmup failed", "err", err)
+		}
+
+		p.lock.Lock()
+		p.warming = false
+		p.lock.Unlock()
+	}()
+}
+
+//关闭链接并更新所属地址的链接数
+func (p *ThriftPool) closeClient(client *IdleClient) error {
+	p.balancer.closed(client.addr)
+	return p.Close(client)
+}
+
+//释放一个链接名额,有等待者时直接转交给等待者新建链接,调用前需要持有锁
+func (p *ThriftPool) release() {
+	if ele := p.waiters.Front(); nil != ele {
+		p.waiters.Remove(ele)
+		ele.Value.(chan waitResult) <- waitResult{dial: true}
+		return
+	}
+
+	if p.count > 0 {
+		p.count -= 1
+	}
+	p.drain()
+}
+
+//关闭之后链接数归零时通知Shutdown,调用前需要持有锁
+func (p *ThriftPool) drain() {
+	if !p.closed || p.count > 0 {
+		return
+	}
+	select {
+	case <-p.drained:
+	default:
+		close(p.drained)
+	}
 }
 
 func (p *ThriftPool) Put(client *IdleClient) error {
@@ -149,15 +452,29 @@ func (p *ThriftPool) Put(client *IdleClient) error {
 		return ErrInvalidConn
 	}
 
-	if client.Client == nil {
+	atomic.AddInt64(&p.stats.puts, 1)
+	return p.put(client)
+}
+
+func (p *ThriftPool) put(client *IdleClient) error {
+	if client.conn() == nil {
 		return nil
 	}
 
 	p.lock.Lock()
+	//已经被回收,名额也已经释放
+	if p.unborrow(client) {
+		p.lock.Unlock()
+		p.Close(client)
+		return ErrReclaimed
+	}
+
 	if p.closed {
+		p.release()
 		p.lock.Unlock()
+		p.evict(evictClosed)
 
-		err := p.Close(client)
+		err := p.closeClient(client)
 		client = nil
 		return err
 	}
@@ -167,19 +484,48 @@ func (p *ThriftPool) Put(client *IdleClient) error {
 			p.count -= 1
 		}
 		p.lock.Unlock()
+		p.evict(evictOverflow)
 
-		err := p.Close(client)
+		err := p.closeClient(client)
+		client = nil
+		return err
+	}
+
+	if reason := p.aged(client); reason != "" {
+		p.release()
+		p.lock.Unlock()
+		p.evict(reason)
+
+		err := p.closeClient(client)
 		client = nil
 		return err
 	}
 
 	if !client.Check() {
-		if p.count > 0 {
-			p.count -= 1
-		}
+		p.release()
 		p.lock.Unlock()
+		p.evict(evictCheck)
 
-		err := p.Close(client)
+		err := p.closeClient(client)
+		client = nil
+		return err
+	}
+
+	//有等待者直接转交
+	if ele := p.waiters.Front(); nil != ele {
+		p.waiters.Remove(ele)
+		ele.Value.(chan waitResult) <- waitResult{c: client}
+		p.lock.Unlock()
+		return nil
+	}
+
+	//空闲链接过多
+	if p.maxIdle > 0 && p.idle.Len() >= p.maxIdle {
+		p.release()
+		p.lock.Unlock()
+		p.evict(evictOverflow)
+
+		err := p.closeClient(client)
 		client = nil
 		return err
 	}
@@ -199,89 +545,225 @@ func (p *ThriftPool) CloseErrConn(client *IdleClient) {
 	}
 
 	p.lock.Lock()
-	if p.count > 0 {
-		p.count -= 1
+	if p.unborrow(client) {
+		p.lock.Unlock()
+		p.Close(client)
+		return
 	}
+	p.release()
 	p.lock.Unlock()
 
-	p.Close(client)
+	p.evict(evictError)
+	p.closeClient(client)
 	client = nil
 	return
 }
 
-func (p *ThriftPool) CheckTimeout() {
-	p.lock.Lock()
+//借出一个链接执行fn,出现传输层错误时关闭链接,否则归还到池子里
+func (p *ThriftPool) Do(fn func(cli *HClient) error) error {
+	client, err := p.Get()
+	if err != nil {
+		return err
+	}
+	return p.do(client, func() error { return fn(client.Client) })
+}
+
+//同Do,链接池满时等待直到ctx结束
+func (p *ThriftPool) DoContext(ctx context.Context, fn func(cli *HClient) error) error {
+	client, err := p.GetContext(ctx)
+	if err != nil {
+		return err
+	}
+	return p.do(client, func() error { return fn(client.Client) })
+}
+
+//同Do,用于Dial出thrift2链接的池子
+func (p *ThriftPool) Do2(fn func(cli *HClient2) error) error {
+	client, err := p.Get()
+	if err != nil {
+		return err
+	}
+	return p.do2(client, fn)
+}
 
+//同DoContext,用于Dial出thrift2链接的池子
+func (p *ThriftPool) DoContext2(ctx context.Context, fn func(cli *HClient2) error) error {
+	client, err := p.GetContext(ctx)
+	if err != nil {
+		return err
+	}
+	return p.do2(client, fn)
+}
+
+func (p *ThriftPool) do2(client *IdleClient, fn func(cli *HClient2) error) error {
+	if client.Client2 == nil {
+		p.Put(client)
+		return ErrNotThrift2
+	}
+	return p.do(client, func() error { return fn(client.Client2) })
+}
+
+func (p *ThriftPool) do(client *IdleClient, fn func() error) (err error) {
+	//fn panic时链接状态未知,同样关闭
+	defer func() {
+		if r := recover(); r != nil {
+			p.CloseErrConn(client)
+			panic(r)
+		}
+	}()
+
+	err = fn()
+	p.putOrClose(client, err)
+	return
+}
+
+//根据最后一次调用的错误归还或者关闭链接
+func (p *ThriftPool) putOrClose(client *IdleClient, err error) {
+	if errors.Is(err, ErrTransport) || isTransportError(err) {
+		p.logger.Info("thrift pool closing connection after transport error", "addr", client.addr, "err", err)
+		//被泄漏检测回收的链接是本地关闭的socket,不计入网关的失败
+		if !p.reclaimed(client) {
+			p.balancer.fail(client.addr)
+		}
+		p.CloseErrConn(client)
+		return
+	}
+
+	//调用方的ctx结束不说明网关的好坏,没有被call中断的链接照常归还
+	if !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded) {
+		p.balancer.rpcSucceeded(client.addr)
+	}
+	p.Put(client)
+}
+
+//回收过期、损坏和过多的空闲链接.checkAlive或者probe需要发送请求,
+//在锁外检查,同时只取出maxConcurrentProbes个,其余的空闲链接照常可以借出
+func (p *ThriftPool) CheckTimeout() {
 	now := nowFunc()
-	removeList := list.New()
-	closeConns := make([]interface{}, 0, removeList.Len())
+	closeConns := make([]*IdleClient, 0, 4)
 
-	for ele := p.idle.Front(); nil != ele; ele = ele.Next() {
+	p.lock.Lock()
+	p.sweeps += 1
+	round := p.sweeps
+	for ele := p.idle.Front(); nil != ele; {
+		next := ele.Next()
 		v := ele.Value.(*idleConn)
-		//已经过期或者损坏
-		if !v.t.Add(p.idleTimeout).After(nowFunc()) || !p.checkAlive(v.c.Client) {
-			//timeout && clear
-			removeList.PushBack(ele)
+		//已经过期、传输层损坏或者地址已经移除
+		if reason := p.expired(v); reason != "" {
+			p.idle.Remove(ele)
+			p.release()
+			closeConns = append(closeConns, v.c)
+			p.evict(reason)
+			if reason == evictCheck {
+				p.logger.Info("thrift pool health check failed", "addr", v.c.addr, "err", ErrInvalidConn)
+				p.balancer.fail(v.c.addr)
+			}
 		}
+		ele = next
 	}
+	p.lock.Unlock()
 
-	//删除指定的需要删除的链接
-	for e := removeList.Front(); nil != e; e = removeList.Front() {
-		remove := p.idle.Remove(removeList.Remove(e).(*list.Element))
-		closeConns = append(closeConns, remove)
-		if p.count > 0 {
-			p.count -= 1
-		}
+	//在锁外检查,慢的网关不会阻塞Get/Put
+	probed := 0
+	if p.probing() {
+		probed = p.probeIdle(round, now)
 	}
 
-	//清理掉过于空闲的链接
+	p.lock.Lock()
+	//清理掉过于空闲的链接,至少保留minIdle个
 	for ele := p.idle.Front(); nil != ele; ele = p.idle.Front() {
-		//检查下空闲列表占比,最多5个空闲
-		if p.count <= p.idle.Len()*4 && p.idle.Len() > 4 {
+		if p.trim != nil && p.idle.Len() > p.minIdle && p.trim(p.count, p.idle.Len()) {
 			v := p.idle.Remove(ele).(*idleConn)
-			closeConns = append(closeConns, v)
+			closeConns = append(closeConns, v.c)
 			if p.count > 0 {
 				p.count -= 1
 			}
+			p.evict(evictOverflow)
 		} else {
 			break
 		}
 	}
 
+	open, idle := p.count, p.idle.Len()
 	p.lock.Unlock()
 
-	log4go.InfoLog("stdout", "ThriftPool|CleanClients|%dms|%d...", time.Now().Sub(now).Milliseconds(), len(closeConns))
+	p.logger.Debug("thrift pool idle sweep", "evicted", len(closeConns), "probed", probed, "duration", nowFunc().Sub(now), "open", open, "idle", idle)
 	//逐个关闭
-	for _, conn := range closeConns {
-		//关闭链接
-		p.Close(conn.(*idleConn).c) //close send connection
+	for _, c := range closeConns {
+		p.closeClient(c) //close send connection
 	}
-	closeConns = nil
-	return
+
+	//回收之后补充到minIdle
+	p.warmup(p.minIdle)
 }
 
-func (c *IdleClient) SetConnTimeout(connTimeout uint32) {
-	if tsocket, ok := c.Client.Trans.(*thrift.TSocket); ok {
-		tsocket.SetTimeout(time.Duration(connTimeout) * time.Second)
+//关闭所有的空闲链接并在后台重新建立同样数量的链接,借出的链接归还时关闭,
+//用于网关滚动重启或者DNS变更之后把流量迁移到新的地址
+func (p *ThriftPool) ForceRecycle() {
+	p.lock.Lock()
+	if p.closed {
+		p.lock.Unlock()
+		return
+	}
+
+	p.recycled = nowFunc()
+	closeConns := make([]*IdleClient, 0, p.idle.Len())
+	for ele := p.idle.Front(); nil != ele; ele = p.idle.Front() {
+		closeConns = append(closeConns, p.idle.Remove(ele).(*idleConn).c)
+		p.release()
+	}
+	p.lock.Unlock()
+
+	p.logger.Info("thrift pool force recycle", "evicted", len(closeConns))
+	for _, client := range closeConns {
+		p.evict(evictRecycled)
+		p.closeClient(client)
+	}
+
+	n := len(closeConns)
+	if n < p.minIdle {
+		n = p.minIdle
 	}
+	p.warmup(n)
+}
+
+//Client或者Client2的传输层
+func (c *IdleClient) conn() *thriftConn {
+	if c.Client != nil {
+		return &c.Client.thriftConn
+	}
+	if c.Client2 != nil {
+		return &c.Client2.thriftConn
+	}
+	return nil
+}
+
+//链接所属的thrift网关地址
+func (c *IdleClient) Addr() string {
+	return c.addr
+}
+
+func (c *IdleClient) SetConnTimeout(connTimeout uint32) {
+	c.conn().SetTimeout(time.Duration(connTimeout) * time.Second)
 }
 
 func (c *IdleClient) LocalAddr() net.Addr {
-	if tsocket, ok := c.Client.Trans.(*thrift.TSocket); ok {
+	if tsocket, ok := c.conn().Trans.(*thrift.TSocket); ok {
 		return tsocket.Conn().LocalAddr()
 	}
 	return nil
 }
 
 func (c *IdleClient) RemoteAddr() net.Addr {
-	if tsocket, ok := c.Client.Trans.(*thrift.TSocket); ok {
+	if tsocket, ok := c.conn().Trans.(*thrift.TSocket); ok {
 		return tsocket.Conn().RemoteAddr()
 	}
 	return nil
 }
 
 func (c *IdleClient) Check() bool {
-	if c.Socket == nil || c.Client == nil || !c.Client.IsAlive() {
+	conn := c.conn()
+	if c.Socket == nil || conn == nil || !conn.IsAlive() {
 		return false
 	}
 	return c.Socket.IsOpen()
@@ -294,32 +776,147 @@ func (p *ThriftPool) GetIdleCount() uint32 {
 }
 
 func (p *ThriftPool) GetConnCount() int {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
 	return p.count
 }
 
+//设置选择地址的策略:BalanceRoundRobin或者BalanceLeastConn
+func (p *ThriftPool) SetBalance(policy int) {
+	p.balancer.lock.Lock()
+	p.balancer.policy = policy
+	p.balancer.lock.Unlock()
+}
+
+//每个地址的链接数和健康状况
+func (p *ThriftPool) GetAddrStats() []AddrStats {
+	return p.balancer.stats()
+}
+
+//正在排队等待链接的调用方数量
+func (p *ThriftPool) GetWaitingCount() int {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	return p.waiters.Len()
+}
+
+//累计等待过的次数
+func (p *ThriftPool) GetWaitCount() int64 {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	return p.waitCount
+}
+
+//累计等待的时长
+func (p *ThriftPool) GetWaitDuration() time.Duration {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	return p.waitDuration
+}
+
 func (p *ThriftPool) ClearConn() {
 	for {
 		select {
 		case <-p.ctx.Done():
 			return
+		case <-p.done:
+			return
 		default:
 
 		}
 
+		p.resolve()
 		p.CheckTimeout()
-		time.Sleep(p.checkInterval)
+		p.detectLeaks()
+
+		timer := time.NewTimer(p.checkInterval)
+		select {
+		case <-p.ctx.Done():
+			timer.Stop()
+			return
+		case <-p.done:
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
 	}
 }
 
+//刷新resolver提供的地址列表,失败或者为空时保留原有地址
+func (p *ThriftPool) resolve() {
+	if p.resolver == nil {
+		return
+	}
+
+	addrs, err := p.resolver()
+	if err != nil {
+		p.logger.Error("thrift pool resolve failed", "err", err)
+		return
+	}
+	if len(addrs) > 0 {
+		p.balancer.update(addrs)
+	}
+}
+
+//关闭池子,不等待借出的链接,它们归还时关闭
 func (p *ThriftPool) Destroy() {
+	p.shutdown()
+}
+
+//关闭池子:停止ClearConn,拒绝新的Get,关闭所有空闲链接,然后等待借出的链接归还并关闭.
+//ctx结束时仍有链接未归还则返回*LeakError
+func (p *ThriftPool) Shutdown(ctx context.Context) error {
+	p.shutdown()
+
+	select {
+	case <-p.drained:
+		return nil
+	case <-ctx.Done():
+	}
+
+	p.lock.RLock()
+	leaked := p.count
+	p.lock.RUnlock()
+	if leaked == 0 {
+		return nil
+	}
+
+	conns := p.BorrowedConns()
+	p.logger.Warn("thrift pool shutdown with leaked connections", "leaked", leaked, "err", ctx.Err())
+	for _, c := range conns {
+		p.logger.Warn("thrift pool connection leaked", "addr", c.Addr, "held", c.Held, "stack", c.Stack)
+	}
+	return &LeakError{Leaked: leaked, Conns: conns, Err: ctx.Err()}
+}
+
+func (p *ThriftPool) shutdown() {
 	p.lock.Lock()
-	idle := p.idle
-	p.idle.Init()
+	if p.closed {
+		p.lock.Unlock()
+		return
+	}
 	p.closed = true
-	p.count = 0
+	close(p.done)
+
+	//唤醒所有等待者
+	for ele := p.waiters.Front(); nil != ele; ele = p.waiters.Front() {
+		p.waiters.Remove(ele).(chan waitResult) <- waitResult{err: ErrPoolClosed}
+	}
+
+	//从链表中取出再关闭,不能复制链表后Init
+	closeConns := make([]*IdleClient, 0, p.idle.Len())
+	for ele := p.idle.Front(); nil != ele; ele = p.idle.Front() {
+		closeConns = append(closeConns, p.idle.Remove(ele).(*idleConn).c)
+		if p.count > 0 {
+			p.count -= 1
+		}
+	}
+	p.drain()
 	p.lock.Unlock()
+	p.metrics.remove(p)
 
-	for iter := idle.Front(); iter != nil; iter = iter.Next() {
-		p.Close(iter.Value.(*idleConn).c)
+	for _, client := range closeConns {
+		p.evict(evictClosed)
+		p.closeClient(client)
 	}
 }
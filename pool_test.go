@@ -0,0 +1,516 @@
+package gogohbase_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	goh "github.com/blackbeans/gogobase"
+	"github.com/blackbeans/gogobase/hbasetest"
+)
+
+func TestWarmupStopsAtMaxIdle(t *testing.T) {
+	srv := hbasetest.NewServer()
+	defer srv.Close()
+
+	pool := goh.NewPool(srv.Addr, goh.WithMaxIdle(2))
+	defer pool.Destroy()
+
+	if err := pool.Warmup(context.Background(), 5); err != nil {
+		t.Fatal(err)
+	}
+	if stats := pool.Stats(); stats.Idle != 2 || stats.Dials != 2 {
+		t.Fatalf("Warmup over maxIdle: %+v, want 2 idle connections and 2 dials", stats)
+	}
+}
+
+func TestMinIdleOverMaxIdle(t *testing.T) {
+	srv := hbasetest.NewServer()
+	defer srv.Close()
+
+	pool := goh.NewPool(srv.Addr, goh.WithMaxIdle(2), goh.WithMinIdle(5),
+		goh.WithHealthCheckInterval(10*time.Millisecond))
+	defer pool.Destroy()
+
+	time.Sleep(100 * time.Millisecond)
+	if stats := pool.Stats(); stats.Idle != 2 || stats.Dials != 2 {
+		t.Fatalf("background warmup over maxIdle: %+v, want 2 idle connections and 2 dials", stats)
+	}
+}
+
+func TestGetDuringHealthCheck(t *testing.T) {
+	srv := hbasetest.NewServer()
+	defer srv.Close()
+
+	var blocking int32
+	started := make(chan struct{}, 6)
+	release := make(chan struct{})
+	probe := func(ctx context.Context, c *goh.IdleClient) error {
+		if atomic.LoadInt32(&blocking) == 1 {
+			started <- struct{}{}
+			<-release
+		}
+		return nil
+	}
+	pool := goh.NewPool(srv.Addr, goh.WithMaxOpen(6), goh.WithProbe(probe),
+		goh.WithHealthCheckInterval(time.Hour))
+	defer pool.Destroy()
+	if err := pool.Warmup(context.Background(), 6); err != nil {
+		t.Fatal(err)
+	}
+
+	atomic.StoreInt32(&blocking, 1)
+	swept := make(chan struct{})
+	go func() {
+		pool.CheckTimeout()
+		close(swept)
+	}()
+	for i := 0; i < 4; i++ {
+		<-started
+	}
+
+	//the connections which are not probed yet can be borrowed
+	var borrowed []*goh.IdleClient
+	for i := 0; i < 2; i++ {
+		c, err := pool.Get()
+		if err != nil {
+			t.Fatalf("Get %d during the health check: %v", i, err)
+		}
+		borrowed = append(borrowed, c)
+	}
+
+	//Get still fails fast when the others are being probed
+	got := make(chan error, 1)
+	go func() {
+		_, err := pool.Get()
+		got <- err
+	}()
+	select {
+	case err := <-got:
+		if err != goh.ErrOverMax {
+			t.Fatalf("got %v, want ErrOverMax", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Get blocked on the health check")
+	}
+
+	close(release)
+	<-swept
+	for _, c := range borrowed {
+		pool.Put(c)
+	}
+	if stats := pool.Stats(); stats.Idle != 6 || stats.HealthCheckClosed != 0 {
+		t.Fatalf("got %+v, want the 6 connections idle", stats)
+	}
+}
+
+func TestHealthCheckClosesOnlyFailures(t *testing.T) {
+	srv := hbasetest.NewServer()
+	defer srv.Close()
+
+	var (
+		lock   sync.Mutex
+		broken *goh.IdleClient
+	)
+	probe := func(ctx context.Context, c *goh.IdleClient) error {
+		lock.Lock()
+		defer lock.Unlock()
+		if broken == nil {
+			broken = c
+		}
+		if c == broken {
+			return errors.New("broken")
+		}
+		return nil
+	}
+	pool := goh.NewPool(srv.Addr, goh.WithProbe(probe), goh.WithHealthCheckInterval(time.Hour))
+	defer pool.Destroy()
+	if err := pool.Warmup(context.Background(), 3); err != nil {
+		t.Fatal(err)
+	}
+
+	pool.CheckTimeout()
+	if stats := pool.Stats(); stats.Idle != 2 || stats.Open != 2 || stats.HealthCheckClosed != 1 {
+		t.Fatalf("got %+v, want only the broken connection closed", stats)
+	}
+}
+
+func TestGetWithTimeoutWhenExhausted(t *testing.T) {
+	srv := hbasetest.NewServer()
+	defer srv.Close()
+
+	pool := goh.NewPool(srv.Addr, goh.WithMaxOpen(1))
+	defer pool.Destroy()
+
+	c, err := pool.Get()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := pool.Get(); err != goh.ErrOverMax {
+		t.Fatalf("got %v, want ErrOverMax", err)
+	}
+	if _, err := pool.GetWithTimeout(20 * time.Millisecond); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("got %v, want context.DeadlineExceeded", err)
+	}
+	if pool.GetWaitingCount() != 0 || pool.GetWaitCount() != 1 {
+		t.Fatalf("waiting %d, waited %d times", pool.GetWaitingCount(), pool.GetWaitCount())
+	}
+
+	pool.Put(c)
+	c, err = pool.GetWithTimeout(20 * time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pool.Put(c)
+}
+
+func TestWaitersServedInOrder(t *testing.T) {
+	srv := hbasetest.NewServer()
+	defer srv.Close()
+
+	pool := goh.NewPool(srv.Addr, goh.WithMaxOpen(1))
+	defer pool.Destroy()
+
+	c, err := pool.Get()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	served := make(chan int, 3)
+	for i := 0; i < 3; i++ {
+		go func(i int) {
+			c, err := pool.GetContext(context.Background())
+			if err != nil {
+				t.Error(err)
+				served <- -1
+				return
+			}
+			served <- i
+			pool.Put(c)
+		}(i)
+		for pool.GetWaitingCount() != i+1 {
+			time.Sleep(time.Millisecond)
+		}
+	}
+
+	pool.Put(c)
+	for i := 0; i < 3; i++ {
+		if got := <-served; got != i {
+			t.Fatalf("waiter %d served before waiter %d", got, i)
+		}
+	}
+	if stats := pool.Stats(); stats.Dials != 1 {
+		t.Fatalf("the connection was not handed over: %+v", stats)
+	}
+}
+
+func TestTransportErrorClosesConnection(t *testing.T) {
+	srv := hbasetest.NewServer()
+	defer srv.Close()
+
+	pool := goh.NewPool(srv.Addr)
+	defer pool.Destroy()
+	if err := pool.Warmup(context.Background(), 1); err != nil {
+		t.Fatal(err)
+	}
+
+	srv.CloseClientConnections()
+	err := pool.Do(func(cli *goh.HClient) error {
+		_, err := cli.GetTableNames()
+		return err
+	})
+	if !errors.Is(err, goh.ErrTransport) {
+		t.Fatalf("got %v, want ErrTransport", err)
+	}
+	if stats := pool.Stats(); stats.ErrorClosed != 1 || stats.Open != 0 {
+		t.Fatalf("the broken connection was kept: %+v", stats)
+	}
+
+	//the next call dials a new connection
+	if err := pool.Do(func(cli *goh.HClient) error {
+		_, err := cli.GetTableNames()
+		return err
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if stats := pool.Stats(); stats.Dials != 2 || stats.Idle != 1 {
+		t.Fatalf("got %+v, want a second connection back in the pool", stats)
+	}
+}
+
+func TestShutdownWaitsForBorrowed(t *testing.T) {
+	srv := hbasetest.NewServer()
+	defer srv.Close()
+
+	pool := goh.NewPool(srv.Addr)
+	c, err := pool.Get()
+	if err != nil {
+		t.Fatal(err)
+	}
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		pool.Put(c)
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := pool.Shutdown(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := pool.Get(); err != goh.ErrPoolClosed {
+		t.Fatalf("Get after Shutdown: got %v, want ErrPoolClosed", err)
+	}
+	if stats := pool.Stats(); stats.Open != 0 {
+		t.Fatalf("connections left open: %+v", stats)
+	}
+}
+
+func TestShutdownReportsLeaks(t *testing.T) {
+	srv := hbasetest.NewServer()
+	defer srv.Close()
+
+	pool := goh.NewPool(srv.Addr, goh.WithLeakDetection(time.Hour))
+	c, err := pool.Get()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer pool.Put(c)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	err = pool.Shutdown(ctx)
+	var leak *goh.LeakError
+	if !errors.As(err, &leak) || leak.Leaked != 1 || len(leak.Conns) != 1 {
+		t.Fatalf("got %v, want a LeakError of one connection", err)
+	}
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("got %v, want it to wrap context.DeadlineExceeded", err)
+	}
+	if leak.Conns[0].Addr != srv.Addr || leak.Conns[0].Stack == "" {
+		t.Fatalf("leaked connection without its Get: %+v", leak.Conns[0])
+	}
+}
+
+func TestLeakReclaim(t *testing.T) {
+	srv := hbasetest.NewServer()
+	defer srv.Close()
+
+	pool := goh.NewPool(srv.Addr, goh.WithMaxOpen(1), goh.WithLeakReclaim(30*time.Millisecond),
+		goh.WithHealthCheckInterval(10*time.Millisecond))
+	defer pool.Destroy()
+
+	leaked, err := pool.Get()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := len(pool.BorrowedConns()); got != 1 {
+		t.Fatalf("%d borrowed connections, want 1", got)
+	}
+
+	//the slot of the leaked connection is given back to the pool
+	c, err := pool.GetWithTimeout(time.Second)
+	if err != nil {
+		t.Fatalf("Get after the leak: %v", err)
+	}
+	//the counters are updated right after the slot is freed
+	for deadline := time.Now().Add(time.Second); ; time.Sleep(time.Millisecond) {
+		stats := pool.Stats()
+		if stats.Leaked == 1 && stats.LeakReclaimed == 1 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("got %+v, want one leaked and reclaimed connection", stats)
+		}
+	}
+
+	//the socket of the reclaimed connection is closed
+	if _, err := leaked.Client.GetTableNames(); err == nil {
+		t.Fatal("rpc on a reclaimed connection succeeded")
+	}
+	if err := pool.Put(leaked); err != goh.ErrReclaimed {
+		t.Fatalf("Put of a reclaimed connection: got %v, want ErrReclaimed", err)
+	}
+	pool.Put(c)
+	if stats := pool.Stats(); stats.Open != 1 || stats.Idle != 1 {
+		t.Fatalf("got %+v, want only the new connection", stats)
+	}
+}
+
+func TestLeakReclaimKeepsBreakerClosed(t *testing.T) {
+	srv := hbasetest.NewServer()
+	defer srv.Close()
+
+	pool := goh.NewPool(srv.Addr, goh.WithLeakReclaim(30*time.Millisecond),
+		goh.WithHealthCheckInterval(10*time.Millisecond), goh.WithBreaker(goh.BreakerOpts{MaxFailures: 1}))
+	defer pool.Destroy()
+
+	err := pool.Do(func(cli *goh.HClient) error {
+		for deadline := time.Now().Add(time.Second); pool.Stats().LeakReclaimed == 0; time.Sleep(time.Millisecond) {
+			if time.Now().After(deadline) {
+				t.Fatal("connection not reclaimed")
+			}
+		}
+		_, err := cli.GetTableNames()
+		return err
+	})
+	if !errors.Is(err, goh.ErrTransport) {
+		t.Fatalf("got %v, want the transport error of the reclaimed socket", err)
+	}
+
+	//the socket was closed here, the gateway is fine
+	if stats := pool.GetAddrStats(); len(stats) != 1 || stats[0].State != goh.BreakerClosed || stats[0].Failures != 0 {
+		t.Fatalf("got %+v, want the breaker closed", stats)
+	}
+	if err := pool.Do(func(cli *goh.HClient) error {
+		_, err := cli.GetTableNames()
+		return err
+	}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// fakeClock is the clock of the pools in the lifetime tests, it only moves forward with Add
+type fakeClock struct {
+	lock sync.Mutex
+	now  time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Add(d time.Duration) {
+	c.lock.Lock()
+	c.now = c.now.Add(d)
+	c.lock.Unlock()
+}
+
+// lifetimePool returns a pool whose connections live an hour on a fake clock,
+// the idle timeout, the idle trim and the health checks stay out of the way
+func lifetimePool(t *testing.T, opts ...goh.Option) (*hbasetest.Server, *goh.ThriftPool, *fakeClock, func()) {
+	clock := &fakeClock{now: time.Unix(1600000000, 0)}
+	restore := goh.SetNow(clock.Now)
+
+	srv := hbasetest.NewServer()
+	opts = append([]goh.Option{
+		goh.WithMaxLifetime(time.Hour),
+		goh.WithIdleTimeout(24 * time.Hour),
+		goh.WithIdleTrim(nil),
+		goh.WithHealthCheckInterval(24 * time.Hour),
+	}, opts...)
+	pool := goh.NewPool(srv.Addr, opts...)
+	return srv, pool, clock, func() {
+		pool.Destroy()
+		srv.Close()
+		restore()
+	}
+}
+
+func TestMaxLifetimeJitter(t *testing.T) {
+	tests := []struct {
+		jitter float64
+		//clock offsets of the health checks and the connections left after each one
+		steps []time.Duration
+		left  []int
+	}{
+		{0, []time.Duration{59 * time.Minute, time.Hour - 1, time.Hour}, []int{20, 20, 0}},
+		//the lifetimes spread over (30m, 1h]
+		{0.5, []time.Duration{30 * time.Minute, 45 * time.Minute, time.Hour}, []int{20, -1, 0}},
+	}
+	for _, tt := range tests {
+		_, pool, clock, stop := lifetimePool(t, goh.WithMaxLifetimeJitter(tt.jitter), goh.WithMaxOpen(20), goh.WithMaxIdle(20))
+		if err := pool.Warmup(context.Background(), 20); err != nil {
+			stop()
+			t.Fatal(err)
+		}
+
+		start := clock.Now()
+		for i, step := range tt.steps {
+			clock.Add(start.Add(step).Sub(clock.Now()))
+			pool.CheckTimeout()
+
+			stats := pool.Stats()
+			//-1: some connections expired, not all of them
+			if want := tt.left[i]; want >= 0 && stats.Idle != want || want < 0 && (stats.Idle == 0 || stats.Idle == 20) {
+				stop()
+				t.Fatalf("jitter %v after %v: %d connections left, want %d", tt.jitter, step, stats.Idle, want)
+			}
+			if stats.LifetimeClosed != int64(20-stats.Idle) {
+				stop()
+				t.Fatalf("jitter %v after %v: got %+v, want the closed connections counted", tt.jitter, step, stats)
+			}
+		}
+		stop()
+	}
+}
+
+func TestMaxLifetimeOnGetAndPut(t *testing.T) {
+	_, pool, clock, stop := lifetimePool(t, goh.WithMaxLifetimeJitter(0))
+	defer stop()
+
+	//an expired idle connection is closed by Get, which dials a new one
+	c, err := pool.Get()
+	if err != nil {
+		t.Fatal(err)
+	}
+	pool.Put(c)
+	clock.Add(time.Hour)
+	if c, err = pool.Get(); err != nil {
+		t.Fatal(err)
+	}
+	if stats := pool.Stats(); stats.Dials != 2 || stats.LifetimeClosed != 1 || stats.Open != 1 {
+		t.Fatalf("got %+v, want the expired connection replaced", stats)
+	}
+
+	//a connection expiring while borrowed is closed by Put
+	clock.Add(time.Hour)
+	if err := pool.Put(c); err != nil {
+		t.Fatal(err)
+	}
+	if stats := pool.Stats(); stats.LifetimeClosed != 2 || stats.Open != 0 || stats.Idle != 0 {
+		t.Fatalf("got %+v, want the expired connection closed on Put", stats)
+	}
+}
+
+func TestForceRecycle(t *testing.T) {
+	_, pool, clock, stop := lifetimePool(t)
+	defer stop()
+
+	if err := pool.Warmup(context.Background(), 3); err != nil {
+		t.Fatal(err)
+	}
+	borrowed, err := pool.Get()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	//the 2 idle connections are closed and dialed again in the background
+	clock.Add(time.Minute)
+	pool.ForceRecycle()
+	for deadline := time.Now().Add(time.Second); ; time.Sleep(time.Millisecond) {
+		stats := pool.Stats()
+		if stats.Idle == 2 && stats.Dials == 5 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("got %+v, want 2 new idle connections", stats)
+		}
+	}
+
+	//the borrowed connection predates the recycle, the new ones are kept
+	pool.Put(borrowed)
+	if stats := pool.Stats(); stats.LifetimeClosed != 3 || stats.Open != 2 || stats.Idle != 2 {
+		t.Fatalf("got %+v, want only the new connections", stats)
+	}
+	if err := pool.Do(getTableNames); err != nil {
+		t.Fatal(err)
+	}
+	if stats := pool.Stats(); stats.Dials != 5 {
+		t.Fatalf("got %+v, want a new connection reused", stats)
+	}
+}
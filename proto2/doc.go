@@ -0,0 +1,7 @@
+/*
+Package proto2 is the client of the thrift2 gateway of HBase, generated from
+Hbase2.thrift by the thrift 0.9.3 compiler like the proto package.
+*/
+package proto2
+
+//go:generate sh -c "thrift --gen go -out .. ../Hbase2.thrift && rm -rf ./*-remote"
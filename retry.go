@@ -0,0 +1,210 @@
+package gogohbase
+
+import (
+	"context"
+	"math/rand"
+	"time"
+)
+
+// defaults of RetryPolicy
+const (
+	defaultRetryAttempts   = 3
+	defaultRetryBackoff    = 50 * time.Millisecond
+	defaultRetryMaxBackoff = 2 * time.Second
+	defaultRetryMultiplier = 2
+	defaultRetryJitter     = 0.2
+)
+
+// rpcs which must not be sent twice: their effect adds up, or they move a scanner
+// whose rows would be skipped by a retry
+var nonIdempotentRPCs = map[string]bool{
+	"AtomicIncrement": true,
+	"Increment":       true,
+	"IncrementRows":   true,
+	"Append":          true,
+	"CheckAndPut":     true,
+	"CheckAndDelete":  true,
+	"CheckAndMutate":  true,
+	"ScannerGet":      true,
+	"ScannerGetList":  true,
+	"GetScannerRows":  true,
+}
+
+/*
+RetryPolicy tells DoRetry how to retry a failed call, the zero values take the defaults.
+
+Only the errors of IsRetryable are retried: transport failures, on a new client,
+and IOErrors of regions moving or servers being busy. A call which sent
+AtomicIncrement, Increment, IncrementRows, Append, CheckAndPut, CheckAndDelete or
+CheckAndMutate is not retried unless AllowNonIdempotent is set, as the first attempt
+may have been applied: a check and mutate retried after it was applied fails its check.
+Neither is a call which read a scanner with ScannerGet, ScannerGetList or GetScannerRows,
+the retry would skip the rows of the lost batch.
+*/
+type RetryPolicy struct {
+	MaxAttempts        int                  //attempts including the first one, 3 by default
+	InitialBackoff     time.Duration        //wait before the first retry, 50ms by default
+	MaxBackoff         time.Duration        //longest wait between two attempts, 2s by default
+	Multiplier         float64              //growth of the wait after each retry, 2 by default, below 1 means 1
+	Jitter             float64              //the wait is shortened by a random fraction up to Jitter, 0.2 by default, at most 1
+	NoJitter           bool                 //wait exactly the backoff, Jitter is ignored
+	Budget             time.Duration        //bounds the call with all its retries, 0 means only the ctx deadline
+	AllowNonIdempotent bool                 //retry the calls which sent a non idempotent rpc
+	Retryable          func(err error) bool //replaces IsRetryable
+}
+
+// DefaultRetryPolicy is used by DoRetry and DoRetry2 when the policy is nil
+var DefaultRetryPolicy = &RetryPolicy{}
+
+/*
+DoRetry is like DoContext, the failed calls are retried as told by policy:
+
+	err := pool.DoRetry(ctx, &goh.RetryPolicy{MaxAttempts: 5, Budget: time.Second}, func(ctx context.Context, cli *goh.HClient) error {
+		return cli.MutateRowCtx(ctx, "table_name", []byte("row"), mutations, nil)
+	})
+
+fn is given the ctx of the attempts, which carries the budget: it must use it for its
+rpcs and be safe to run again.
+*/
+func (p *ThriftPool) DoRetry(ctx context.Context, policy *RetryPolicy, fn func(ctx context.Context, cli *HClient) error) error {
+	return p.retry(ctx, policy, func(ctx context.Context) error {
+		client, err := p.GetContext(ctx)
+		if err != nil {
+			return err
+		}
+		return p.attempt(client, func() error { return fn(ctx, client.Client) })
+	})
+}
+
+/*
+DoRetry2 is DoRetry for thrift2 clients
+*/
+func (p *ThriftPool) DoRetry2(ctx context.Context, policy *RetryPolicy, fn func(ctx context.Context, cli *HClient2) error) error {
+	return p.retry(ctx, policy, func(ctx context.Context) error {
+		client, err := p.GetContext(ctx)
+		if err != nil {
+			return err
+		}
+		if client.Client2 == nil {
+			p.Put(client)
+			return ErrNotThrift2
+		}
+		return p.attempt(client, func() error { return fn(ctx, client.Client2) })
+	})
+}
+
+// errNonIdempotent marks the failed attempts which sent a non idempotent rpc
+type errNonIdempotent struct {
+	err error
+}
+
+func (e errNonIdempotent) Error() string {
+	return e.err.Error()
+}
+
+// attempt runs fn on client like do, and tells whether a non idempotent rpc was sent
+func (p *ThriftPool) attempt(client *IdleClient, fn func() error) error {
+	conn := client.conn()
+	if conn != nil {
+		conn.nonIdempotent = false
+	}
+
+	sent := false
+	err := p.do(client, func() error {
+		err := fn()
+		sent = conn != nil && conn.nonIdempotent
+		return err
+	})
+	if err != nil && sent {
+		return errNonIdempotent{err}
+	}
+	return err
+}
+
+func (p *ThriftPool) retry(ctx context.Context, policy *RetryPolicy, call func(ctx context.Context) error) error {
+	if policy == nil {
+		policy = DefaultRetryPolicy
+	}
+	if policy.Budget > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, policy.Budget)
+		defer cancel()
+	}
+
+	attempts := policy.MaxAttempts
+	if attempts <= 0 {
+		attempts = defaultRetryAttempts
+	}
+	retryable := policy.Retryable
+	if retryable == nil {
+		retryable = IsRetryable
+	}
+
+	var err error
+	for attempt := 1; ; attempt++ {
+		err = call(ctx)
+		if err == nil {
+			return nil
+		}
+
+		if e, ok := err.(errNonIdempotent); ok {
+			err = e.err
+			if !policy.AllowNonIdempotent {
+				return err
+			}
+		}
+		if attempt >= attempts || !retryable(err) {
+			return err
+		}
+
+		//等待超过deadline时不再重试
+		backoff := policy.backoff(attempt)
+		if deadline, ok := ctx.Deadline(); ok && !nowFunc().Add(backoff).Before(deadline) {
+			return err
+		}
+
+		p.logger.Info("thrift pool retrying call", "attempt", attempt, "backoff", backoff, "err", err)
+		timer := time.NewTimer(backoff)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		}
+	}
+}
+
+// backoff is the wait after the attempt-th failed attempt: exponential, capped and shortened by the jitter
+func (policy *RetryPolicy) backoff(attempt int) time.Duration {
+	backoff := policy.InitialBackoff
+	if backoff <= 0 {
+		backoff = defaultRetryBackoff
+	}
+	maxBackoff := policy.MaxBackoff
+	if maxBackoff <= 0 {
+		maxBackoff = defaultRetryMaxBackoff
+	}
+	multiplier := policy.Multiplier
+	if multiplier == 0 {
+		multiplier = defaultRetryMultiplier
+	} else if multiplier < 1 {
+		multiplier = 1
+	}
+	jitter := policy.Jitter
+	if policy.NoJitter {
+		jitter = 0
+	} else if jitter <= 0 {
+		jitter = defaultRetryJitter
+	} else if jitter > 1 {
+		jitter = 1
+	}
+
+	d := float64(backoff)
+	for i := 1; i < attempt && d < float64(maxBackoff); i++ {
+		d *= multiplier
+	}
+	if d > float64(maxBackoff) {
+		d = float64(maxBackoff)
+	}
+	return time.Duration(d - rand.Float64()*jitter*d)
+}
@@ -0,0 +1,90 @@
+package gogohbase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	goh "github.com/blackbeans/gogobase"
+)
+
+func TestRetryBackoffWithoutJitter(t *testing.T) {
+	policy := &goh.RetryPolicy{InitialBackoff: 10 * time.Millisecond, MaxBackoff: time.Second, NoJitter: true}
+	for attempt, want := range []time.Duration{10, 20, 40, 80} {
+		if got := goh.RetryBackoff(policy, attempt+1); got != want*time.Millisecond {
+			t.Fatalf("attempt %d: got %v, want %v", attempt+1, got, want*time.Millisecond)
+		}
+	}
+}
+
+func TestRetryBackoffConstantMultiplier(t *testing.T) {
+	policy := &goh.RetryPolicy{InitialBackoff: 10 * time.Millisecond, Multiplier: 0.5, NoJitter: true}
+	for attempt := 1; attempt <= 4; attempt++ {
+		if got := goh.RetryBackoff(policy, attempt); got != 10*time.Millisecond {
+			t.Fatalf("attempt %d: got %v, want a constant 10ms", attempt, got)
+		}
+	}
+}
+
+func TestRetryBackoffJitter(t *testing.T) {
+	policy := &goh.RetryPolicy{InitialBackoff: 100 * time.Millisecond}
+	for i := 0; i < 100; i++ {
+		got := goh.RetryBackoff(policy, 1)
+		if got > 100*time.Millisecond || got < 80*time.Millisecond {
+			t.Fatalf("got %v, want within the default jitter of 100ms", got)
+		}
+	}
+}
+
+func TestRetryBudgetBoundsTheRPC(t *testing.T) {
+	ln := silentServer(t)
+	defer ln.Close()
+
+	pool := goh.NewPool(ln.Addr().String())
+	defer pool.Destroy()
+
+	start := time.Now()
+	err := pool.DoRetry(context.Background(), &goh.RetryPolicy{Budget: 50 * time.Millisecond},
+		func(ctx context.Context, cli *goh.HClient) error {
+			if _, ok := ctx.Deadline(); !ok {
+				t.Error("the ctx of the attempt has no deadline")
+			}
+			_, err := cli.GetTableNamesCtx(ctx)
+			return err
+		})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("got %v, want context.DeadlineExceeded", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("the rpc outlived the budget by %v", elapsed)
+	}
+}
+
+func TestRetrySkipsScannerReads(t *testing.T) {
+	srv, pool := scanFixture(t, 10)
+	defer srv.Close()
+	defer pool.Destroy()
+
+	var id int32
+	if err := pool.Do(func(cli *goh.HClient) (err error) {
+		id, err = cli.ScannerOpenWithScan("t", &goh.TScan{}, nil)
+		return
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	attempts := 0
+	err := pool.DoRetry(context.Background(), &goh.RetryPolicy{MaxAttempts: 3, NoJitter: true},
+		func(ctx context.Context, cli *goh.HClient) error {
+			if attempts++; attempts == 1 {
+				//the batch is lost once the request is sent
+				srv.CloseClientConnections()
+			}
+			_, err := cli.ScannerGetListCtx(ctx, id, 2)
+			return err
+		})
+	if !errors.Is(err, goh.ErrTransport) || attempts != 1 {
+		t.Fatalf("%d attempts, err %v, want a single failed attempt", attempts, err)
+	}
+}
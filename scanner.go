@@ -0,0 +1,247 @@
+package gogohbase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/blackbeans/gogobase/proto"
+)
+
+const (
+	defaultScanBatch    = 100             //TScan.Caching未设置时每批拉取的行数
+	scannerCloseTimeout = 5 * time.Second //关闭服务端scanner的超时
+)
+
+type scanBatch struct {
+	rows []*proto.TRowResult_
+	err  error
+}
+
+/*
+Scanner iterates the rows of a server side scanner opened with
+ScannerOpenWithScan. Rows are fetched in batches of TScan.Caching rows and
+the next batch is prefetched while the current one is consumed. The server
+side scanner is closed on EOF, on error, when ctx is done and on Close.
+
+ctx stops the scan but does not interrupt a prefetch in flight, which would
+break the connection and leave the server side scanner open: Close waits for
+the prefetch, up to 5s, then closes the scanner on the same connection.
+
+A Scanner pins its connection until it is closed, so the scanner id stays
+valid; it must not be used from several goroutines at once.
+
+	scanner, err := pool.Scan(ctx, "table_name", scan, nil)
+	if err != nil {
+		return err
+	}
+	defer scanner.Close()
+
+	for scanner.Next() {
+		row := scanner.Row()
+		...
+	}
+	return scanner.Err()
+*/
+type Scanner struct {
+	ctx    context.Context
+	fetch  context.Context    //ctx of the prefetches, not cancelled with ctx
+	cancel context.CancelFunc //interrupts the prefetch Close gave up waiting for
+	pool   *ThriftPool        //nil if the client is not pooled
+	idle   *IdleClient
+	client *HClient
+	id     int32
+	batch  int32
+
+	rows    []*proto.TRowResult_
+	row     *proto.TRowResult_
+	pending chan scanBatch //in-flight prefetch
+	eof     bool
+	err     error
+
+	closed   bool
+	closeErr error
+}
+
+/*
+Scan opens a scanner on the table with a pooled client which is returned to
+the pool when the scanner is closed.
+*/
+func (p *ThriftPool) Scan(ctx context.Context, tableName string, scan *TScan, attributes map[string]string) (*Scanner, error) {
+	idle, err := p.GetContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := openScanner(ctx, idle.Client, tableName, scan, attributes)
+	if err != nil {
+		p.putOrClose(idle, err)
+		return nil, err
+	}
+
+	s.pool = p
+	s.idle = idle
+	return s, nil
+}
+
+/*
+Scan opens a scanner on the table with this client. The client must not be
+used for anything else until the scanner is closed.
+*/
+func (client *HClient) Scan(ctx context.Context, tableName string, scan *TScan, attributes map[string]string) (*Scanner, error) {
+	return openScanner(ctx, client, tableName, scan, attributes)
+}
+
+func openScanner(ctx context.Context, client *HClient, tableName string, scan *TScan, attributes map[string]string) (*Scanner, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	id, err := client.ScannerOpenWithScanCtx(ctx, tableName, scan, attributes)
+	if err != nil {
+		return nil, err
+	}
+
+	batch := int32(defaultScanBatch)
+	if scan != nil && scan.Caching != nil && *scan.Caching > 0 {
+		batch = *scan.Caching
+	}
+
+	fetch, cancel := context.WithCancel(context.Background())
+	return &Scanner{
+		ctx:    ctx,
+		fetch:  fetch,
+		cancel: cancel,
+		client: client,
+		id:     id,
+		batch:  batch,
+	}, nil
+}
+
+/*
+Next advances the scanner to the next row, which will then be available
+through Row. It returns false when the scan stops, either by reaching the end
+or an error; the scanner is closed at that point.
+*/
+func (s *Scanner) Next() bool {
+	if s.closed || s.err != nil {
+		return false
+	}
+
+	if err := s.ctx.Err(); err != nil {
+		s.fail(err)
+		return false
+	}
+
+	if len(s.rows) == 0 {
+		if s.eof {
+			s.Close()
+			return false
+		}
+
+		if s.pending == nil {
+			s.prefetch()
+		}
+		var b scanBatch
+		select {
+		case b = <-s.pending:
+		case <-s.ctx.Done():
+			s.fail(s.ctx.Err())
+			return false
+		}
+		s.pending = nil
+
+		if b.err != nil {
+			s.fail(b.err)
+			return false
+		}
+
+		//不足一批说明已经到了末尾,不用再多请求一次
+		if int32(len(b.rows)) < s.batch {
+			s.eof = true
+		}
+
+		if len(b.rows) == 0 {
+			s.Close()
+			return false
+		}
+
+		s.rows = b.rows
+		if !s.eof {
+			s.prefetch()
+		}
+	}
+
+	s.row = s.rows[0]
+	s.rows[0] = nil
+	s.rows = s.rows[1:]
+	return true
+}
+
+/*
+Row returns the current row
+*/
+func (s *Scanner) Row() *proto.TRowResult_ {
+	return s.row
+}
+
+/*
+Err returns the error, if any, that stopped the scan
+*/
+func (s *Scanner) Err() error {
+	return s.err
+}
+
+/*
+Close closes the server side scanner and returns the pinned client to the
+pool. It is safe to call Close more than once.
+*/
+func (s *Scanner) Close() error {
+	if s.closed {
+		return s.closeErr
+	}
+	s.closed = true
+	s.rows = nil
+
+	//等待未完成的预取,链接不能并发使用.预取超时才中断,链接随之损坏
+	if s.pending != nil {
+		timer := time.AfterFunc(scannerCloseTimeout, s.cancel)
+		if b := <-s.pending; b.err != nil && s.err == nil {
+			s.err = b.err
+		}
+		timer.Stop()
+		s.pending = nil
+	}
+	s.cancel()
+
+	var err error
+	if s.client.IsAlive() && !errors.Is(s.err, ErrTransport) {
+		ctx, cancel := context.WithTimeout(context.Background(), scannerCloseTimeout)
+		err = s.client.ScannerCloseCtx(ctx, s.id)
+		cancel()
+		s.closeErr = err
+	}
+
+	if s.pool != nil {
+		if err == nil {
+			err = s.err
+		}
+		s.pool.putOrClose(s.idle, err)
+		s.idle = nil
+	}
+	return s.closeErr
+}
+
+func (s *Scanner) prefetch() {
+	ch := make(chan scanBatch, 1)
+	s.pending = ch
+	go func() {
+		rows, err := s.client.ScannerGetListCtx(s.fetch, s.id, s.batch)
+		ch <- scanBatch{rows: rows, err: err}
+	}()
+}
+
+func (s *Scanner) fail(err error) {
+	s.err = err
+	s.Close()
+}
@@ -0,0 +1,36 @@
+//go:build go1.23
+
+package gogohbase
+
+import (
+	"iter"
+
+	"github.com/blackbeans/gogobase/proto"
+)
+
+/*
+Rows returns an iterator over the remaining rows of the scanner. The scanner
+is closed when the loop ends, and an error which stopped the scan is yielded
+as the last element:
+
+	for row, err := range scanner.Rows() {
+		if err != nil {
+			return err
+		}
+		...
+	}
+*/
+func (s *Scanner) Rows() iter.Seq2[*proto.TRowResult_, error] {
+	return func(yield func(*proto.TRowResult_, error) bool) {
+		defer s.Close()
+		for s.Next() {
+			if !yield(s.Row(), nil) {
+				return
+			}
+		}
+
+		if err := s.Err(); err != nil {
+			yield(nil, err)
+		}
+	}
+}
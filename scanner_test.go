@@ -0,0 +1,264 @@
+package gogohbase_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"git.apache.org/thrift.git/lib/go/thrift"
+	goh "github.com/blackbeans/gogobase"
+	"github.com/blackbeans/gogobase/hbasetest"
+	"github.com/blackbeans/gogobase/proto"
+)
+
+// scanFixture returns a pool on a table t of rows row00..row{n-1}
+func scanFixture(t *testing.T, n int) (*hbasetest.Server, *goh.ThriftPool) {
+	srv := hbasetest.NewServer()
+	srv.Fake.MustCreateTable("t", "cf")
+
+	pool := goh.NewPool(srv.Addr)
+	err := pool.Do(func(cli *goh.HClient) error {
+		for i := 0; i < n; i++ {
+			mutations := []*proto.Mutation{{Column: proto.Text("cf:a"), Value: proto.Text(fmt.Sprint(i))}}
+			if err := cli.MutateRow("t", []byte(fmt.Sprintf("row%02d", i)), mutations, nil); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		pool.Destroy()
+		srv.Close()
+		t.Fatal(err)
+	}
+	return srv, pool
+}
+
+func TestScannerBatches(t *testing.T) {
+	srv, pool := scanFixture(t, 25)
+	defer srv.Close()
+	defer pool.Destroy()
+
+	caching := int32(10)
+	scanner, err := pool.Scan(context.Background(), "t", &goh.TScan{StartRow: []byte("row05"), Caching: &caching}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer scanner.Close()
+
+	var rows []string
+	for scanner.Next() {
+		rows = append(rows, string(scanner.Row().Row))
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 20 || rows[0] != "row05" || rows[19] != "row24" || !sort.StringsAreSorted(rows) {
+		t.Fatalf("got %v, want row05..row24 in order", rows)
+	}
+
+	//the pinned client is back in the pool
+	if stats := pool.Stats(); stats.InUse != 0 || stats.Idle != 1 {
+		t.Fatalf("got %+v, want the client returned", stats)
+	}
+}
+
+func TestScannerStopsWithContext(t *testing.T) {
+	srv, pool := scanFixture(t, 10)
+	defer srv.Close()
+	defer pool.Destroy()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	caching := int32(2)
+	scanner, err := pool.Scan(ctx, "t", &goh.TScan{Caching: &caching}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	n := 0
+	for scanner.Next() {
+		if n++; n == 3 {
+			cancel()
+		}
+	}
+	if !errors.Is(scanner.Err(), context.Canceled) || n != 3 {
+		t.Fatalf("scanned %d rows, err %v, want 3 rows and context.Canceled", n, scanner.Err())
+	}
+	if err := scanner.Close(); err != nil && !errors.Is(err, context.Canceled) {
+		t.Fatal(err)
+	}
+	if stats := pool.Stats(); stats.InUse != 0 {
+		t.Fatalf("got %+v, want the client released", stats)
+	}
+}
+
+// slowScans holds the second ScannerGetList until release is closed and reports the closed scanners
+type slowScans struct {
+	*hbasetest.Fake
+	calls    int32
+	fetching chan struct{} //closed once the second ScannerGetList is received
+	release  chan struct{}
+	closed   chan proto.ScannerID
+}
+
+func (s *slowScans) ScannerGetList(id proto.ScannerID, nbRows int32) ([]*proto.TRowResult_, error) {
+	if atomic.AddInt32(&s.calls, 1) == 2 {
+		close(s.fetching)
+		<-s.release
+	}
+	return s.Fake.ScannerGetList(id, nbRows)
+}
+
+func (s *slowScans) ScannerClose(id proto.ScannerID) error {
+	s.closed <- id
+	return s.Fake.ScannerClose(id)
+}
+
+func TestScannerClosedAfterCancelledPrefetch(t *testing.T) {
+	fake := hbasetest.NewFake()
+	fake.MustCreateTable("t", "cf")
+	for i := 0; i < 10; i++ {
+		mutations := []*proto.Mutation{{Column: proto.Text("cf:a"), Value: proto.Text(fmt.Sprint(i))}}
+		if err := fake.MutateRow(proto.Text("t"), proto.Text(fmt.Sprintf("row%02d", i)), mutations, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	handler := &slowScans{
+		Fake:     fake,
+		fetching: make(chan struct{}),
+		release:  make(chan struct{}),
+		closed:   make(chan proto.ScannerID, 1),
+	}
+
+	socket, err := thrift.NewTServerSocket("127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := thrift.NewTSimpleServer4(proto.NewHbaseProcessor(handler), socket,
+		thrift.NewTTransportFactory(), thrift.NewTBinaryProtocolFactoryDefault())
+	if err := server.Listen(); err != nil {
+		t.Fatal(err)
+	}
+	go server.AcceptLoop()
+	defer server.Stop()
+
+	pool := goh.NewPool(socket.Addr().String())
+	defer pool.Destroy()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	caching := int32(2)
+	scanner, err := pool.Scan(ctx, "t", &goh.TScan{Caching: &caching}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !scanner.Next() {
+		t.Fatal(scanner.Err())
+	}
+
+	//cancel while the second batch is prefetched
+	<-handler.fetching
+	cancel()
+	time.AfterFunc(50*time.Millisecond, func() { close(handler.release) })
+	if scanner.Next() || !errors.Is(scanner.Err(), context.Canceled) {
+		t.Fatalf("got %v, want context.Canceled", scanner.Err())
+	}
+	if err := scanner.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case <-handler.closed:
+	default:
+		t.Fatal("server side scanner left open")
+	}
+	if stats := pool.Stats(); stats.InUse != 0 || stats.Idle != 1 {
+		t.Fatalf("got %+v, want the client returned", stats)
+	}
+}
+
+func TestScanMissingTable(t *testing.T) {
+	srv := hbasetest.NewServer()
+	defer srv.Close()
+	pool := goh.NewPool(srv.Addr)
+	defer pool.Destroy()
+
+	if _, err := pool.Scan(context.Background(), "missing", &goh.TScan{}, nil); !errors.Is(err, goh.ErrTableNotFound) {
+		t.Fatalf("got %v, want ErrTableNotFound", err)
+	}
+	if stats := pool.Stats(); stats.InUse != 0 {
+		t.Fatalf("got %+v, want the client released", stats)
+	}
+}
+
+func TestParallelScan(t *testing.T) {
+	srv, pool := scanFixture(t, 30)
+	defer srv.Close()
+	defer pool.Destroy()
+
+	var (
+		lock sync.Mutex
+		rows []string
+	)
+	scan := &goh.TScan{StartRow: []byte("row10"), StopRow: []byte("row20")}
+	err := pool.ParallelScan(context.Background(), "t", scan, nil, 2, func(region *goh.TRegionInfo, row *proto.TRowResult_) error {
+		lock.Lock()
+		rows = append(rows, string(row.Row))
+		lock.Unlock()
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sort.Strings(rows)
+	if len(rows) != 10 || rows[0] != "row10" || rows[9] != "row19" {
+		t.Fatalf("got %v, want row10..row19", rows)
+	}
+}
+
+func TestParallelScanHandlerError(t *testing.T) {
+	srv, pool := scanFixture(t, 10)
+	defer srv.Close()
+	defer pool.Destroy()
+
+	stop := errors.New("stop")
+	n := 0
+	err := pool.ParallelScan(context.Background(), "t", &goh.TScan{}, nil, 1, func(region *goh.TRegionInfo, row *proto.TRowResult_) error {
+		if n++; n == 2 {
+			return stop
+		}
+		return nil
+	})
+	var errs goh.ParallelScanError
+	if !errors.As(err, &errs) || len(errs) != 1 || errs[0].Err != stop {
+		t.Fatalf("got %v, want the error of the handler", err)
+	}
+	if n != 2 {
+		t.Fatalf("handler called %d times after its error", n)
+	}
+	if stats := pool.Stats(); stats.InUse != 0 {
+		t.Fatalf("got %+v, want the clients released", stats)
+	}
+}
+
+func TestParallelScanCancelled(t *testing.T) {
+	srv, pool := scanFixture(t, 10)
+	defer srv.Close()
+	defer pool.Destroy()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := pool.ParallelScan(ctx, "t", &goh.TScan{}, nil, 1, func(region *goh.TRegionInfo, row *proto.TRowResult_) error {
+		t.Error("handler called after the ctx was cancelled")
+		return nil
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("got %v, want context.Canceled", err)
+	}
+}
@@ -0,0 +1,108 @@
+package gogohbase
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+/*
+Stats is a snapshot of a ThriftPool, like database/sql.DBStats
+*/
+type Stats struct {
+	MaxOpen int //链接数上限
+
+	//当前的链接
+	Open  int //打开的链接,包括正在Dial的
+	InUse int //借出或者正在健康检查的链接
+	Idle  int
+
+	//累计值
+	WaitCount         int64         //等待过链接的次数
+	WaitDuration      time.Duration //等待链接的总时长
+	MaxIdleClosed     int64         //超过链接数上限或者空闲链接过多而关闭的
+	IdleTimeClosed    int64         //空闲超时关闭的
+	LifetimeClosed    int64         //超过MaxLifetime或者被ForceRecycle关闭的
+	HealthCheckClosed int64         //传输层损坏或者健康检查失败关闭的
+	ErrorClosed       int64         //调用出现传输层错误后关闭的
+	Leaked            int64         //借出超过泄漏阈值的
+	LeakReclaimed     int64         //被泄漏检测回收的
+	Dials             int64
+	DialErrors        int64
+	Gets              int64 //成功借出的次数
+	Puts              int64 //归还的次数
+}
+
+// 累计值,单独分配以保证64位对齐
+type poolStats struct {
+	maxIdleClosed     int64
+	idleTimeClosed    int64
+	lifetimeClosed    int64
+	healthCheckClosed int64
+	errorClosed       int64
+	leaked            int64
+	leakReclaimed     int64
+	dials             int64
+	dialErrors        int64
+	gets              int64
+	puts              int64
+}
+
+// 链接池的统计快照
+func (p *ThriftPool) Stats() Stats {
+	p.lock.RLock()
+	stats := Stats{
+		MaxOpen:      p.maxConn,
+		Open:         p.count,
+		InUse:        p.count - p.idle.Len() - p.checking,
+		Idle:         p.idle.Len(),
+		WaitCount:    p.waitCount,
+		WaitDuration: p.waitDuration,
+	}
+	p.lock.RUnlock()
+
+	s := p.stats
+	stats.MaxIdleClosed = atomic.LoadInt64(&s.maxIdleClosed)
+	stats.IdleTimeClosed = atomic.LoadInt64(&s.idleTimeClosed)
+	stats.LifetimeClosed = atomic.LoadInt64(&s.lifetimeClosed)
+	stats.HealthCheckClosed = atomic.LoadInt64(&s.healthCheckClosed)
+	stats.ErrorClosed = atomic.LoadInt64(&s.errorClosed)
+	stats.Leaked = atomic.LoadInt64(&s.leaked)
+	stats.LeakReclaimed = atomic.LoadInt64(&s.leakReclaimed)
+	stats.Dials = atomic.LoadInt64(&s.dials)
+	stats.DialErrors = atomic.LoadInt64(&s.dialErrors)
+	stats.Gets = atomic.LoadInt64(&s.gets)
+	stats.Puts = atomic.LoadInt64(&s.puts)
+	return stats
+}
+
+// 记录关闭链接的原因
+func (p *ThriftPool) evict(reason string) {
+	var n *int64
+	switch reason {
+	case evictOverflow:
+		n = &p.stats.maxIdleClosed
+	case evictTimeout:
+		n = &p.stats.idleTimeClosed
+	case evictLifetime, evictRecycled:
+		n = &p.stats.lifetimeClosed
+	case evictCheck:
+		n = &p.stats.healthCheckClosed
+	case evictError:
+		n = &p.stats.errorClosed
+	case evictLeaked:
+		n = &p.stats.leakReclaimed
+	}
+	if n != nil {
+		atomic.AddInt64(n, 1)
+	}
+	p.metrics.evict(reason)
+}
+
+// 记录一次Dial
+func (p *ThriftPool) dialed(err error) {
+	atomic.AddInt64(&p.stats.dials, 1)
+	if err != nil {
+		atomic.AddInt64(&p.stats.dialErrors, 1)
+	}
+	p.metrics.dial(err)
+}